@@ -0,0 +1,299 @@
+package alexa
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// APL directive and request types
+const (
+	APLRenderDocument  = "Alexa.Presentation.APL.RenderDocument"
+	APLExecuteCommands = "Alexa.Presentation.APL.ExecuteCommands"
+	APLUserEvent       = "Alexa.Presentation.APL.UserEvent"
+)
+
+// APLRenderDocumentDirective shows an APL document on the screen of the device
+type APLRenderDocumentDirective struct {
+	Type string `json:"type"`
+	// Token identifies the document for ExecuteCommands and UserEvent requests
+	Token string `json:"token,omitempty"`
+	// Document is the APL document, inline as JSON or a map, or an APLLink to a document
+	// saved in the authoring tool
+	Document    interface{}            `json:"document"`
+	Datasources map[string]interface{} `json:"datasources,omitempty"`
+}
+
+// APLLink refers to an APL document saved with the skill in the authoring tool
+type APLLink struct {
+	Type string `json:"type"`
+	Src  string `json:"src"`
+}
+
+// NewAPLLink refers to the APL document saved with the skill under the name
+func NewAPLLink(name string) APLLink {
+	return APLLink{Type: "Link", Src: "doc://alexa/apl/documents/" + name}
+}
+
+// APLExecuteCommandsDirective runs commands on the document shown by a RenderDocument
+// directive with the same token
+type APLExecuteCommandsDirective struct {
+	Type     string       `json:"type"`
+	Token    string       `json:"token"`
+	Commands []APLCommand `json:"commands"`
+}
+
+// APLEventSource is the component that sent an Alexa.Presentation.APL.UserEvent request
+type APLEventSource struct {
+	Type    string      `json:"type"`
+	Handler string      `json:"handler"`
+	ID      string      `json:"id,omitempty"`
+	Value   interface{} `json:"value,omitempty"`
+}
+
+// APLVisualContext is the APL document shown on the screen, sent as
+// context["Alexa.Presentation.APL"]
+type APLVisualContext struct {
+	Token   string `json:"token"`
+	Version string `json:"version,omitempty"`
+}
+
+// APLCommand is a command of an APLExecuteCommandsDirective or of a Sequential or
+// Parallel command.  The type is added when the command is encoded.
+type APLCommand interface {
+	CommandType() string
+}
+
+// marshalCommand encodes the fields of a command after its type.  v must not have a
+// MarshalJSON method of its own.
+func marshalCommand(commandType string, v interface{}) ([]byte, error) {
+	fields, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	typeField := fmt.Sprintf(`{"type":%q`, commandType)
+	if string(fields) == "{}" {
+		return []byte(typeField + "}"), nil
+	}
+	return append([]byte(typeField+","), fields[1:]...), nil
+}
+
+// APLCommonFields are the properties every command has
+type APLCommonFields struct {
+	Delay       int    `json:"delay,omitempty"`
+	Description string `json:"description,omitempty"`
+	When        string `json:"when,omitempty"`
+}
+
+// APLIdleCommand does nothing for its delay
+type APLIdleCommand struct {
+	APLCommonFields
+}
+
+// APLSendEventCommand sends an Alexa.Presentation.APL.UserEvent request to the skill
+type APLSendEventCommand struct {
+	APLCommonFields
+	Arguments  []interface{} `json:"arguments,omitempty"`
+	Components []string      `json:"components,omitempty"`
+}
+
+// APLSetPageCommand changes the page of a Pager component
+type APLSetPageCommand struct {
+	APLCommonFields
+	ComponentID string `json:"componentId"`
+	// Position is "relative" or "absolute"
+	Position string `json:"position,omitempty"`
+	Value    int    `json:"value"`
+}
+
+// APLAutoPageCommand walks through the pages of a Pager component
+type APLAutoPageCommand struct {
+	APLCommonFields
+	ComponentID string `json:"componentId"`
+	Count       int    `json:"count,omitempty"`
+	Duration    int    `json:"duration,omitempty"`
+}
+
+// APLScrollCommand scrolls a ScrollView or Sequence by a number of pages
+type APLScrollCommand struct {
+	APLCommonFields
+	ComponentID string  `json:"componentId"`
+	Distance    float64 `json:"distance"`
+}
+
+// APLScrollToIndexCommand scrolls a Sequence to the child at the index
+type APLScrollToIndexCommand struct {
+	APLCommonFields
+	ComponentID string `json:"componentId"`
+	Index       int    `json:"index"`
+	// Align is "first", "center", "last" or "visible"
+	Align string `json:"align,omitempty"`
+}
+
+// APLSpeakItemCommand reads the speech of a component, scrolling it into view
+type APLSpeakItemCommand struct {
+	APLCommonFields
+	ComponentID string `json:"componentId"`
+	Align       string `json:"align,omitempty"`
+	// HighlightMode is "line" or "block"
+	HighlightMode    string `json:"highlightMode,omitempty"`
+	MinimumDwellTime int    `json:"minimumDwellTime,omitempty"`
+}
+
+// APLSpeakListCommand reads the speech of Count children of a Sequence from Start
+type APLSpeakListCommand struct {
+	APLCommonFields
+	ComponentID      string `json:"componentId"`
+	Start            int    `json:"start"`
+	Count            int    `json:"count"`
+	Align            string `json:"align,omitempty"`
+	MinimumDwellTime int    `json:"minimumDwellTime,omitempty"`
+}
+
+// APLSetValueCommand changes a property of a component
+type APLSetValueCommand struct {
+	APLCommonFields
+	ComponentID string      `json:"componentId,omitempty"`
+	Property    string      `json:"property"`
+	Value       interface{} `json:"value"`
+}
+
+// APLControlMediaCommand plays, pauses or seeks a Video component
+type APLControlMediaCommand struct {
+	APLCommonFields
+	ComponentID string `json:"componentId"`
+	// Command is play, pause, next, previous, rewind, seek or setTrack
+	Command string `json:"command"`
+	Value   int    `json:"value,omitempty"`
+}
+
+// APLSequentialCommand runs commands one after the other
+type APLSequentialCommand struct {
+	APLCommonFields
+	Commands        []APLCommand `json:"commands"`
+	CatchCommands   []APLCommand `json:"catch,omitempty"`
+	FinallyCommands []APLCommand `json:"finally,omitempty"`
+	Repeat          int          `json:"repeatCount,omitempty"`
+}
+
+// APLParallelCommand runs commands at the same time
+type APLParallelCommand struct {
+	APLCommonFields
+	Commands []APLCommand `json:"commands"`
+}
+
+// APLCustomCommand is any other command, given with its "type" property
+type APLCustomCommand map[string]interface{}
+
+// CommandType returns the type property of the command
+func (c APLCustomCommand) CommandType() string {
+	t, _ := c["type"].(string)
+	return t
+}
+
+// CommandType implements APLCommand
+func (c APLIdleCommand) CommandType() string { return "Idle" }
+
+// CommandType implements APLCommand
+func (c APLSendEventCommand) CommandType() string { return "SendEvent" }
+
+// CommandType implements APLCommand
+func (c APLSetPageCommand) CommandType() string { return "SetPage" }
+
+// CommandType implements APLCommand
+func (c APLAutoPageCommand) CommandType() string { return "AutoPage" }
+
+// CommandType implements APLCommand
+func (c APLScrollCommand) CommandType() string { return "Scroll" }
+
+// CommandType implements APLCommand
+func (c APLScrollToIndexCommand) CommandType() string { return "ScrollToIndex" }
+
+// CommandType implements APLCommand
+func (c APLSpeakItemCommand) CommandType() string { return "SpeakItem" }
+
+// CommandType implements APLCommand
+func (c APLSpeakListCommand) CommandType() string { return "SpeakList" }
+
+// CommandType implements APLCommand
+func (c APLSetValueCommand) CommandType() string { return "SetValue" }
+
+// CommandType implements APLCommand
+func (c APLControlMediaCommand) CommandType() string { return "ControlMedia" }
+
+// CommandType implements APLCommand
+func (c APLSequentialCommand) CommandType() string { return "Sequential" }
+
+// CommandType implements APLCommand
+func (c APLParallelCommand) CommandType() string { return "Parallel" }
+
+// MarshalJSON adds the command type
+func (c APLIdleCommand) MarshalJSON() ([]byte, error) {
+	type plain APLIdleCommand
+	return marshalCommand(c.CommandType(), plain(c))
+}
+
+// MarshalJSON adds the command type
+func (c APLSendEventCommand) MarshalJSON() ([]byte, error) {
+	type plain APLSendEventCommand
+	return marshalCommand(c.CommandType(), plain(c))
+}
+
+// MarshalJSON adds the command type
+func (c APLSetPageCommand) MarshalJSON() ([]byte, error) {
+	type plain APLSetPageCommand
+	return marshalCommand(c.CommandType(), plain(c))
+}
+
+// MarshalJSON adds the command type
+func (c APLAutoPageCommand) MarshalJSON() ([]byte, error) {
+	type plain APLAutoPageCommand
+	return marshalCommand(c.CommandType(), plain(c))
+}
+
+// MarshalJSON adds the command type
+func (c APLScrollCommand) MarshalJSON() ([]byte, error) {
+	type plain APLScrollCommand
+	return marshalCommand(c.CommandType(), plain(c))
+}
+
+// MarshalJSON adds the command type
+func (c APLScrollToIndexCommand) MarshalJSON() ([]byte, error) {
+	type plain APLScrollToIndexCommand
+	return marshalCommand(c.CommandType(), plain(c))
+}
+
+// MarshalJSON adds the command type
+func (c APLSpeakItemCommand) MarshalJSON() ([]byte, error) {
+	type plain APLSpeakItemCommand
+	return marshalCommand(c.CommandType(), plain(c))
+}
+
+// MarshalJSON adds the command type
+func (c APLSpeakListCommand) MarshalJSON() ([]byte, error) {
+	type plain APLSpeakListCommand
+	return marshalCommand(c.CommandType(), plain(c))
+}
+
+// MarshalJSON adds the command type
+func (c APLSetValueCommand) MarshalJSON() ([]byte, error) {
+	type plain APLSetValueCommand
+	return marshalCommand(c.CommandType(), plain(c))
+}
+
+// MarshalJSON adds the command type
+func (c APLControlMediaCommand) MarshalJSON() ([]byte, error) {
+	type plain APLControlMediaCommand
+	return marshalCommand(c.CommandType(), plain(c))
+}
+
+// MarshalJSON adds the command type
+func (c APLSequentialCommand) MarshalJSON() ([]byte, error) {
+	type plain APLSequentialCommand
+	return marshalCommand(c.CommandType(), plain(c))
+}
+
+// MarshalJSON adds the command type
+func (c APLParallelCommand) MarshalJSON() ([]byte, error) {
+	type plain APLParallelCommand
+	return marshalCommand(c.CommandType(), plain(c))
+}
@@ -0,0 +1,60 @@
+package alexa_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/spirilis/askgo/alexa"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_APLCommands(t *testing.T) {
+	commands := []alexa.APLCommand{
+		alexa.APLSequentialCommand{Commands: []alexa.APLCommand{
+			alexa.APLSpeakItemCommand{ComponentID: "question", HighlightMode: "line"},
+			alexa.APLSetPageCommand{ComponentID: "pager", Position: "relative", Value: 1},
+			alexa.APLIdleCommand{APLCommonFields: alexa.APLCommonFields{Delay: 500}},
+		}},
+		alexa.APLSendEventCommand{Arguments: []interface{}{"done"}},
+		alexa.APLCustomCommand{"type": "Reinflate"},
+	}
+	require.Equal(t, "Reinflate", commands[2].CommandType())
+
+	data, err := json.Marshal(commands)
+	require.NoError(t, err)
+	require.JSONEq(t, `[
+		{"type": "Sequential", "commands": [
+			{"type": "SpeakItem", "componentId": "question", "highlightMode": "line"},
+			{"type": "SetPage", "componentId": "pager", "position": "relative", "value": 1},
+			{"type": "Idle", "delay": 500}
+		]},
+		{"type": "SendEvent", "arguments": ["done"]},
+		{"type": "Reinflate"}
+	]`, string(data))
+
+	require.Equal(t, alexa.APLLink{Type: "Link", Src: "doc://alexa/apl/documents/quiz"}, alexa.NewAPLLink("quiz"))
+}
+
+func Test_APLUserEvent(t *testing.T) {
+	var envelope alexa.RequestEnvelope
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"context": {"Alexa.Presentation.APL": {"token": "quiz", "version": "APL_WEB_RENDERER_GANDALF"}},
+		"request": {
+			"type": "Alexa.Presentation.APL.UserEvent",
+			"requestId": "amzn1.echo-api.request.1",
+			"locale": "en-US",
+			"token": "quiz",
+			"arguments": ["answer", 2],
+			"source": {"type": "TouchWrapper", "handler": "Press", "id": "answer2"},
+			"components": {"name": "Ada"}
+		}
+	}`), &envelope))
+
+	request := envelope.Request
+	require.Equal(t, alexa.APLUserEvent, request.Type)
+	require.Equal(t, "quiz", request.Token)
+	require.Equal(t, []interface{}{"answer", float64(2)}, request.Arguments)
+	require.Equal(t, &alexa.APLEventSource{Type: "TouchWrapper", Handler: "Press", ID: "answer2"}, request.Source)
+	require.Equal(t, "Ada", request.Components["name"])
+	require.Equal(t, "quiz", envelope.Context.APL.Token)
+}
@@ -0,0 +1,445 @@
+// Code generated by builtin-gen from builtins.yaml; DO NOT EDIT.
+
+package alexa
+
+// Built-in intents
+const (
+	// CancelIntent is AMAZON.CancelIntent
+	CancelIntent = "AMAZON.CancelIntent"
+	// FallbackIntent is AMAZON.FallbackIntent
+	FallbackIntent = "AMAZON.FallbackIntent"
+	// HelpIntent is AMAZON.HelpIntent
+	HelpIntent = "AMAZON.HelpIntent"
+	// LoopOffIntent is AMAZON.LoopOffIntent
+	LoopOffIntent = "AMAZON.LoopOffIntent"
+	// LoopOnIntent is AMAZON.LoopOnIntent
+	LoopOnIntent = "AMAZON.LoopOnIntent"
+	// MoreIntent is AMAZON.MoreIntent
+	MoreIntent = "AMAZON.MoreIntent"
+	// NavigateHomeIntent is AMAZON.NavigateHomeIntent
+	NavigateHomeIntent = "AMAZON.NavigateHomeIntent"
+	// NavigateSettingsIntent is AMAZON.NavigateSettingsIntent
+	NavigateSettingsIntent = "AMAZON.NavigateSettingsIntent"
+	// NextIntent is AMAZON.NextIntent
+	NextIntent = "AMAZON.NextIntent"
+	// NoIntent is AMAZON.NoIntent
+	NoIntent = "AMAZON.NoIntent"
+	// PageDownIntent is AMAZON.PageDownIntent
+	PageDownIntent = "AMAZON.PageDownIntent"
+	// PageUpIntent is AMAZON.PageUpIntent
+	PageUpIntent = "AMAZON.PageUpIntent"
+	// PauseIntent is AMAZON.PauseIntent
+	PauseIntent = "AMAZON.PauseIntent"
+	// PreviousIntent is AMAZON.PreviousIntent
+	PreviousIntent = "AMAZON.PreviousIntent"
+	// RepeatIntent is AMAZON.RepeatIntent
+	RepeatIntent = "AMAZON.RepeatIntent"
+	// ResumeIntent is AMAZON.ResumeIntent
+	ResumeIntent = "AMAZON.ResumeIntent"
+	// ScrollDownIntent is AMAZON.ScrollDownIntent
+	ScrollDownIntent = "AMAZON.ScrollDownIntent"
+	// ScrollLeftIntent is AMAZON.ScrollLeftIntent
+	ScrollLeftIntent = "AMAZON.ScrollLeftIntent"
+	// ScrollRightIntent is AMAZON.ScrollRightIntent
+	ScrollRightIntent = "AMAZON.ScrollRightIntent"
+	// ScrollUpIntent is AMAZON.ScrollUpIntent
+	ScrollUpIntent = "AMAZON.ScrollUpIntent"
+	// SelectIntent is AMAZON.SelectIntent
+	SelectIntent = "AMAZON.SelectIntent"
+	// SendToPhoneIntent is AMAZON.SendToPhoneIntent
+	SendToPhoneIntent = "AMAZON.SendToPhoneIntent"
+	// ShuffleOffIntent is AMAZON.ShuffleOffIntent
+	ShuffleOffIntent = "AMAZON.ShuffleOffIntent"
+	// ShuffleOnIntent is AMAZON.ShuffleOnIntent
+	ShuffleOnIntent = "AMAZON.ShuffleOnIntent"
+	// StartOverIntent is AMAZON.StartOverIntent
+	StartOverIntent = "AMAZON.StartOverIntent"
+	// StopIntent is AMAZON.StopIntent
+	StopIntent = "AMAZON.StopIntent"
+	// YesIntent is AMAZON.YesIntent
+	YesIntent = "AMAZON.YesIntent"
+	// AddBookToReadingListIntent is AMAZON.AddAction<object@Book,targetCollection@ReadingList>
+	AddBookToReadingListIntent = "AMAZON.AddAction<object@Book,targetCollection@ReadingList>"
+	// AddMusicToPlaylistIntent is AMAZON.AddAction<object@MusicCreativeWork,targetCollection@MusicPlaylist>
+	AddMusicToPlaylistIntent = "AMAZON.AddAction<object@MusicCreativeWork,targetCollection@MusicPlaylist>"
+	// AddScreeningEventToCalendarIntent is AMAZON.AddAction<object@ScreeningEvent,targetCollection@Calendar>
+	AddScreeningEventToCalendarIntent = "AMAZON.AddAction<object@ScreeningEvent,targetCollection@Calendar>"
+	// ChooseMusicIntent is AMAZON.ChooseAction<object@MusicCreativeWork>
+	ChooseMusicIntent = "AMAZON.ChooseAction<object@MusicCreativeWork>"
+	// DeleteMusicPlaylistIntent is AMAZON.DeleteAction<object@MusicPlaylist>
+	DeleteMusicPlaylistIntent = "AMAZON.DeleteAction<object@MusicPlaylist>"
+	// LikeMusicIntent is AMAZON.LikeAction<object@MusicCreativeWork>
+	LikeMusicIntent = "AMAZON.LikeAction<object@MusicCreativeWork>"
+	// PlayMusicIntent is AMAZON.PlaybackAction<object@MusicCreativeWork>
+	PlayMusicIntent = "AMAZON.PlaybackAction<object@MusicCreativeWork>"
+	// PlayMusicRecordingIntent is AMAZON.PlaybackAction<object@MusicRecording>
+	PlayMusicRecordingIntent = "AMAZON.PlaybackAction<object@MusicRecording>"
+	// PlayVideoIntent is AMAZON.PlaybackAction<object@VideoCreativeWork>
+	PlayVideoIntent = "AMAZON.PlaybackAction<object@VideoCreativeWork>"
+	// RateBookIntent is AMAZON.RateAction<object@Book>
+	RateBookIntent = "AMAZON.RateAction<object@Book>"
+	// ReplaceMusicIntent is AMAZON.ReplaceAction<object@MusicCreativeWork>
+	ReplaceMusicIntent = "AMAZON.ReplaceAction<object@MusicCreativeWork>"
+	// ResumeBookIntent is AMAZON.ResumeAction<object@Book>
+	ResumeBookIntent = "AMAZON.ResumeAction<object@Book>"
+	// SearchBookIntent is AMAZON.SearchAction<object@Book>
+	SearchBookIntent = "AMAZON.SearchAction<object@Book>"
+	// SearchBookAuthorIntent is AMAZON.SearchAction<object@Book[author]>
+	SearchBookAuthorIntent = "AMAZON.SearchAction<object@Book[author]>"
+	// SearchLocalBusinessIntent is AMAZON.SearchAction<object@LocalBusiness>
+	SearchLocalBusinessIntent = "AMAZON.SearchAction<object@LocalBusiness>"
+	// SearchWeatherIntent is AMAZON.SearchAction<object@Weather>
+	SearchWeatherIntent = "AMAZON.SearchAction<object@Weather>"
+	// SearchWeatherForecastIntent is AMAZON.SearchAction<object@WeatherForecast>
+	SearchWeatherForecastIntent = "AMAZON.SearchAction<object@WeatherForecast>"
+	// SearchWeatherTemperatureIntent is AMAZON.SearchAction<object@WeatherForecast[temperature]>
+	SearchWeatherTemperatureIntent = "AMAZON.SearchAction<object@WeatherForecast[temperature]>"
+)
+
+// BuiltInIntents describes every built-in intent by name
+var BuiltInIntents = map[string]BuiltIn{
+	CancelIntent:                      {Name: CancelIntent},
+	FallbackIntent:                    {Name: FallbackIntent},
+	HelpIntent:                        {Name: HelpIntent},
+	LoopOffIntent:                     {Name: LoopOffIntent},
+	LoopOnIntent:                      {Name: LoopOnIntent},
+	MoreIntent:                        {Name: MoreIntent},
+	NavigateHomeIntent:                {Name: NavigateHomeIntent},
+	NavigateSettingsIntent:            {Name: NavigateSettingsIntent},
+	NextIntent:                        {Name: NextIntent},
+	NoIntent:                          {Name: NoIntent},
+	PageDownIntent:                    {Name: PageDownIntent},
+	PageUpIntent:                      {Name: PageUpIntent},
+	PauseIntent:                       {Name: PauseIntent},
+	PreviousIntent:                    {Name: PreviousIntent},
+	RepeatIntent:                      {Name: RepeatIntent},
+	ResumeIntent:                      {Name: ResumeIntent},
+	ScrollDownIntent:                  {Name: ScrollDownIntent},
+	ScrollLeftIntent:                  {Name: ScrollLeftIntent},
+	ScrollRightIntent:                 {Name: ScrollRightIntent},
+	ScrollUpIntent:                    {Name: ScrollUpIntent},
+	SelectIntent:                      {Name: SelectIntent, Locales: []string{"en", "de-DE", "es", "fr", "it-IT", "ja-JP", "pt-BR"}},
+	SendToPhoneIntent:                 {Name: SendToPhoneIntent, Locales: []string{"en-US"}},
+	ShuffleOffIntent:                  {Name: ShuffleOffIntent},
+	ShuffleOnIntent:                   {Name: ShuffleOnIntent},
+	StartOverIntent:                   {Name: StartOverIntent},
+	StopIntent:                        {Name: StopIntent},
+	YesIntent:                         {Name: YesIntent},
+	AddBookToReadingListIntent:        {Name: AddBookToReadingListIntent, Locales: []string{"en-US"}},
+	AddMusicToPlaylistIntent:          {Name: AddMusicToPlaylistIntent, Locales: []string{"en-US"}},
+	AddScreeningEventToCalendarIntent: {Name: AddScreeningEventToCalendarIntent, Locales: []string{"en-US"}},
+	ChooseMusicIntent:                 {Name: ChooseMusicIntent, Locales: []string{"en-US"}},
+	DeleteMusicPlaylistIntent:         {Name: DeleteMusicPlaylistIntent, Locales: []string{"en-US"}},
+	LikeMusicIntent:                   {Name: LikeMusicIntent, Locales: []string{"en-US"}},
+	PlayMusicIntent:                   {Name: PlayMusicIntent, Locales: []string{"en-US", "en-GB", "de-DE"}},
+	PlayMusicRecordingIntent:          {Name: PlayMusicRecordingIntent, Locales: []string{"en-US", "en-GB", "de-DE"}},
+	PlayVideoIntent:                   {Name: PlayVideoIntent, Locales: []string{"en-US"}},
+	RateBookIntent:                    {Name: RateBookIntent, Locales: []string{"en-US"}},
+	ReplaceMusicIntent:                {Name: ReplaceMusicIntent, Locales: []string{"en-US"}},
+	ResumeBookIntent:                  {Name: ResumeBookIntent, Locales: []string{"en-US"}},
+	SearchBookIntent:                  {Name: SearchBookIntent, Locales: []string{"en-US"}},
+	SearchBookAuthorIntent:            {Name: SearchBookAuthorIntent, Locales: []string{"en-US"}},
+	SearchLocalBusinessIntent:         {Name: SearchLocalBusinessIntent, Locales: []string{"en-US"}},
+	SearchWeatherIntent:               {Name: SearchWeatherIntent, Locales: []string{"en-US", "en-GB", "de-DE"}},
+	SearchWeatherForecastIntent:       {Name: SearchWeatherForecastIntent, Locales: []string{"en-US", "en-GB", "de-DE"}},
+	SearchWeatherTemperatureIntent:    {Name: SearchWeatherTemperatureIntent, Locales: []string{"en-US", "en-GB", "de-DE"}},
+}
+
+// Built-in slot types
+const (
+	// DateSlotType is AMAZON.DATE
+	DateSlotType = "AMAZON.DATE"
+	// DurationSlotType is AMAZON.DURATION
+	DurationSlotType = "AMAZON.DURATION"
+	// FourDigitNumberSlotType is AMAZON.FOUR_DIGIT_NUMBER
+	FourDigitNumberSlotType = "AMAZON.FOUR_DIGIT_NUMBER"
+	// NumberSlotType is AMAZON.NUMBER
+	NumberSlotType = "AMAZON.NUMBER"
+	// OrdinalSlotType is AMAZON.Ordinal
+	OrdinalSlotType = "AMAZON.Ordinal"
+	// PhoneNumberSlotType is AMAZON.PhoneNumber
+	PhoneNumberSlotType = "AMAZON.PhoneNumber"
+	// TimeSlotType is AMAZON.TIME
+	TimeSlotType = "AMAZON.TIME"
+	// SearchQuerySlotType is AMAZON.SearchQuery
+	SearchQuerySlotType = "AMAZON.SearchQuery"
+	// ActorSlotType is AMAZON.Actor
+	ActorSlotType = "AMAZON.Actor"
+	// AirlineSlotType is AMAZON.Airline
+	AirlineSlotType = "AMAZON.Airline"
+	// AirportSlotType is AMAZON.Airport
+	AirportSlotType = "AMAZON.Airport"
+	// AnimalSlotType is AMAZON.Animal
+	AnimalSlotType = "AMAZON.Animal"
+	// ArtistSlotType is AMAZON.Artist
+	ArtistSlotType = "AMAZON.Artist"
+	// ATCitySlotType is AMAZON.AT_CITY
+	ATCitySlotType = "AMAZON.AT_CITY"
+	// ATRegionSlotType is AMAZON.AT_REGION
+	ATRegionSlotType = "AMAZON.AT_REGION"
+	// AthleteSlotType is AMAZON.Athlete
+	AthleteSlotType = "AMAZON.Athlete"
+	// AuthorSlotType is AMAZON.Author
+	AuthorSlotType = "AMAZON.Author"
+	// BookSlotType is AMAZON.Book
+	BookSlotType = "AMAZON.Book"
+	// BookSeriesSlotType is AMAZON.BookSeries
+	BookSeriesSlotType = "AMAZON.BookSeries"
+	// BroadcastChannelSlotType is AMAZON.BroadcastChannel
+	BroadcastChannelSlotType = "AMAZON.BroadcastChannel"
+	// CitySlotType is AMAZON.City
+	CitySlotType = "AMAZON.City"
+	// CivicStructureSlotType is AMAZON.CivicStructure
+	CivicStructureSlotType = "AMAZON.CivicStructure"
+	// ColorSlotType is AMAZON.Color
+	ColorSlotType = "AMAZON.Color"
+	// CorporationSlotType is AMAZON.Corporation
+	CorporationSlotType = "AMAZON.Corporation"
+	// CountrySlotType is AMAZON.Country
+	CountrySlotType = "AMAZON.Country"
+	// CreativeWorkTypeSlotType is AMAZON.CreativeWorkType
+	CreativeWorkTypeSlotType = "AMAZON.CreativeWorkType"
+	// DayOfWeekSlotType is AMAZON.DayOfWeek
+	DayOfWeekSlotType = "AMAZON.DayOfWeek"
+	// DECitySlotType is AMAZON.DE_CITY
+	DECitySlotType = "AMAZON.DE_CITY"
+	// DEFirstNameSlotType is AMAZON.DE_FIRST_NAME
+	DEFirstNameSlotType = "AMAZON.DE_FIRST_NAME"
+	// DERegionSlotType is AMAZON.DE_REGION
+	DERegionSlotType = "AMAZON.DE_REGION"
+	// DessertSlotType is AMAZON.Dessert
+	DessertSlotType = "AMAZON.Dessert"
+	// DeviceTypeSlotType is AMAZON.DeviceType
+	DeviceTypeSlotType = "AMAZON.DeviceType"
+	// DirectorSlotType is AMAZON.Director
+	DirectorSlotType = "AMAZON.Director"
+	// DrinkSlotType is AMAZON.Drink
+	DrinkSlotType = "AMAZON.Drink"
+	// EducationalOrganizationSlotType is AMAZON.EducationalOrganization
+	EducationalOrganizationSlotType = "AMAZON.EducationalOrganization"
+	// EuropeCitySlotType is AMAZON.EUROPE_CITY
+	EuropeCitySlotType = "AMAZON.EUROPE_CITY"
+	// EventTypeSlotType is AMAZON.EventType
+	EventTypeSlotType = "AMAZON.EventType"
+	// FestivalSlotType is AMAZON.Festival
+	FestivalSlotType = "AMAZON.Festival"
+	// FictionalCharacterSlotType is AMAZON.FictionalCharacter
+	FictionalCharacterSlotType = "AMAZON.FictionalCharacter"
+	// FinancialServiceSlotType is AMAZON.FinancialService
+	FinancialServiceSlotType = "AMAZON.FinancialService"
+	// FirstNameSlotType is AMAZON.FirstName
+	FirstNameSlotType = "AMAZON.FirstName"
+	// FoodSlotType is AMAZON.Food
+	FoodSlotType = "AMAZON.Food"
+	// FoodEstablishmentSlotType is AMAZON.FoodEstablishment
+	FoodEstablishmentSlotType = "AMAZON.FoodEstablishment"
+	// GameSlotType is AMAZON.Game
+	GameSlotType = "AMAZON.Game"
+	// GBCitySlotType is AMAZON.GB_CITY
+	GBCitySlotType = "AMAZON.GB_CITY"
+	// GBFirstNameSlotType is AMAZON.GB_FIRST_NAME
+	GBFirstNameSlotType = "AMAZON.GB_FIRST_NAME"
+	// GBRegionSlotType is AMAZON.GB_REGION
+	GBRegionSlotType = "AMAZON.GB_REGION"
+	// GenreSlotType is AMAZON.Genre
+	GenreSlotType = "AMAZON.Genre"
+	// LandformSlotType is AMAZON.Landform
+	LandformSlotType = "AMAZON.Landform"
+	// LandmarksOrHistoricalBuildingsSlotType is AMAZON.LandmarksOrHistoricalBuildings
+	LandmarksOrHistoricalBuildingsSlotType = "AMAZON.LandmarksOrHistoricalBuildings"
+	// LanguageSlotType is AMAZON.Language
+	LanguageSlotType = "AMAZON.Language"
+	// LocalBusinessSlotType is AMAZON.LocalBusiness
+	LocalBusinessSlotType = "AMAZON.LocalBusiness"
+	// LocalBusinessTypeSlotType is AMAZON.LocalBusinessType
+	LocalBusinessTypeSlotType = "AMAZON.LocalBusinessType"
+	// MedicalOrganizationSlotType is AMAZON.MedicalOrganization
+	MedicalOrganizationSlotType = "AMAZON.MedicalOrganization"
+	// MonthSlotType is AMAZON.Month
+	MonthSlotType = "AMAZON.Month"
+	// MovieSlotType is AMAZON.Movie
+	MovieSlotType = "AMAZON.Movie"
+	// MovieSeriesSlotType is AMAZON.MovieSeries
+	MovieSeriesSlotType = "AMAZON.MovieSeries"
+	// MovieTheaterSlotType is AMAZON.MovieTheater
+	MovieTheaterSlotType = "AMAZON.MovieTheater"
+	// MusicAlbumSlotType is AMAZON.MusicAlbum
+	MusicAlbumSlotType = "AMAZON.MusicAlbum"
+	// MusicCreativeWorkTypeSlotType is AMAZON.MusicCreativeWorkType
+	MusicCreativeWorkTypeSlotType = "AMAZON.MusicCreativeWorkType"
+	// MusicEventSlotType is AMAZON.MusicEvent
+	MusicEventSlotType = "AMAZON.MusicEvent"
+	// MusicGroupSlotType is AMAZON.MusicGroup
+	MusicGroupSlotType = "AMAZON.MusicGroup"
+	// MusicianSlotType is AMAZON.Musician
+	MusicianSlotType = "AMAZON.Musician"
+	// MusicPlaylistSlotType is AMAZON.MusicPlaylist
+	MusicPlaylistSlotType = "AMAZON.MusicPlaylist"
+	// MusicRecordingSlotType is AMAZON.MusicRecording
+	MusicRecordingSlotType = "AMAZON.MusicRecording"
+	// MusicVenueSlotType is AMAZON.MusicVenue
+	MusicVenueSlotType = "AMAZON.MusicVenue"
+	// MusicVideoSlotType is AMAZON.MusicVideo
+	MusicVideoSlotType = "AMAZON.MusicVideo"
+	// OrganizationSlotType is AMAZON.Organization
+	OrganizationSlotType = "AMAZON.Organization"
+	// PersonSlotType is AMAZON.Person
+	PersonSlotType = "AMAZON.Person"
+	// PostalAddressSlotType is AMAZON.PostalAddress
+	PostalAddressSlotType = "AMAZON.PostalAddress"
+	// ProfessionalSlotType is AMAZON.Professional
+	ProfessionalSlotType = "AMAZON.Professional"
+	// ProfessionalTypeSlotType is AMAZON.ProfessionalType
+	ProfessionalTypeSlotType = "AMAZON.ProfessionalType"
+	// RadioChannelSlotType is AMAZON.RadioChannel
+	RadioChannelSlotType = "AMAZON.RadioChannel"
+	// RegionSlotType is AMAZON.Region
+	RegionSlotType = "AMAZON.Region"
+	// RelativePositionSlotType is AMAZON.RelativePosition
+	RelativePositionSlotType = "AMAZON.RelativePosition"
+	// ResidenceSlotType is AMAZON.Residence
+	ResidenceSlotType = "AMAZON.Residence"
+	// RoomSlotType is AMAZON.Room
+	RoomSlotType = "AMAZON.Room"
+	// ScreeningEventSlotType is AMAZON.ScreeningEvent
+	ScreeningEventSlotType = "AMAZON.ScreeningEvent"
+	// ServiceSlotType is AMAZON.Service
+	ServiceSlotType = "AMAZON.Service"
+	// SocialMediaPlatformSlotType is AMAZON.SocialMediaPlatform
+	SocialMediaPlatformSlotType = "AMAZON.SocialMediaPlatform"
+	// SoftwareApplicationSlotType is AMAZON.SoftwareApplication
+	SoftwareApplicationSlotType = "AMAZON.SoftwareApplication"
+	// SoftwareGameSlotType is AMAZON.SoftwareGame
+	SoftwareGameSlotType = "AMAZON.SoftwareGame"
+	// SportsEventSlotType is AMAZON.SportsEvent
+	SportsEventSlotType = "AMAZON.SportsEvent"
+	// SportsTeamSlotType is AMAZON.SportsTeam
+	SportsTeamSlotType = "AMAZON.SportsTeam"
+	// StreetAddressSlotType is AMAZON.StreetAddress
+	StreetAddressSlotType = "AMAZON.StreetAddress"
+	// StreetNameSlotType is AMAZON.StreetName
+	StreetNameSlotType = "AMAZON.StreetName"
+	// TelevisionChannelSlotType is AMAZON.TelevisionChannel
+	TelevisionChannelSlotType = "AMAZON.TelevisionChannel"
+	// TVEpisodeSlotType is AMAZON.TVEpisode
+	TVEpisodeSlotType = "AMAZON.TVEpisode"
+	// TVSeasonSlotType is AMAZON.TVSeason
+	TVSeasonSlotType = "AMAZON.TVSeason"
+	// TVSeriesSlotType is AMAZON.TVSeries
+	TVSeriesSlotType = "AMAZON.TVSeries"
+	// USCitySlotType is AMAZON.US_CITY
+	USCitySlotType = "AMAZON.US_CITY"
+	// USFirstNameSlotType is AMAZON.US_FIRST_NAME
+	USFirstNameSlotType = "AMAZON.US_FIRST_NAME"
+	// USStateSlotType is AMAZON.US_STATE
+	USStateSlotType = "AMAZON.US_STATE"
+	// VideoGameSlotType is AMAZON.VideoGame
+	VideoGameSlotType = "AMAZON.VideoGame"
+	// VisualModeTriggerSlotType is AMAZON.VisualModeTrigger
+	VisualModeTriggerSlotType = "AMAZON.VisualModeTrigger"
+	// WeatherConditionSlotType is AMAZON.WeatherCondition
+	WeatherConditionSlotType = "AMAZON.WeatherCondition"
+	// WrittenCreativeWorkTypeSlotType is AMAZON.WrittenCreativeWorkType
+	WrittenCreativeWorkTypeSlotType = "AMAZON.WrittenCreativeWorkType"
+)
+
+// BuiltInSlotTypes describes every built-in slot type by name
+var BuiltInSlotTypes = map[string]BuiltIn{
+	DateSlotType:                           {Name: DateSlotType},
+	DurationSlotType:                       {Name: DurationSlotType},
+	FourDigitNumberSlotType:                {Name: FourDigitNumberSlotType},
+	NumberSlotType:                         {Name: NumberSlotType},
+	OrdinalSlotType:                        {Name: OrdinalSlotType},
+	PhoneNumberSlotType:                    {Name: PhoneNumberSlotType},
+	TimeSlotType:                           {Name: TimeSlotType},
+	SearchQuerySlotType:                    {Name: SearchQuerySlotType},
+	ActorSlotType:                          {Name: ActorSlotType},
+	AirlineSlotType:                        {Name: AirlineSlotType},
+	AirportSlotType:                        {Name: AirportSlotType},
+	AnimalSlotType:                         {Name: AnimalSlotType},
+	ArtistSlotType:                         {Name: ArtistSlotType},
+	ATCitySlotType:                         {Name: ATCitySlotType, Locales: []string{"de-DE"}},
+	ATRegionSlotType:                       {Name: ATRegionSlotType, Locales: []string{"de-DE"}},
+	AthleteSlotType:                        {Name: AthleteSlotType},
+	AuthorSlotType:                         {Name: AuthorSlotType},
+	BookSlotType:                           {Name: BookSlotType},
+	BookSeriesSlotType:                     {Name: BookSeriesSlotType},
+	BroadcastChannelSlotType:               {Name: BroadcastChannelSlotType},
+	CitySlotType:                           {Name: CitySlotType},
+	CivicStructureSlotType:                 {Name: CivicStructureSlotType},
+	ColorSlotType:                          {Name: ColorSlotType},
+	CorporationSlotType:                    {Name: CorporationSlotType},
+	CountrySlotType:                        {Name: CountrySlotType},
+	CreativeWorkTypeSlotType:               {Name: CreativeWorkTypeSlotType},
+	DayOfWeekSlotType:                      {Name: DayOfWeekSlotType},
+	DECitySlotType:                         {Name: DECitySlotType, Locales: []string{"de-DE"}},
+	DEFirstNameSlotType:                    {Name: DEFirstNameSlotType, Locales: []string{"de-DE"}},
+	DERegionSlotType:                       {Name: DERegionSlotType, Locales: []string{"de-DE"}},
+	DessertSlotType:                        {Name: DessertSlotType},
+	DeviceTypeSlotType:                     {Name: DeviceTypeSlotType},
+	DirectorSlotType:                       {Name: DirectorSlotType},
+	DrinkSlotType:                          {Name: DrinkSlotType},
+	EducationalOrganizationSlotType:        {Name: EducationalOrganizationSlotType},
+	EuropeCitySlotType:                     {Name: EuropeCitySlotType, Locales: []string{"en-GB", "de-DE", "es-ES", "fr-FR", "it-IT"}},
+	EventTypeSlotType:                      {Name: EventTypeSlotType},
+	FestivalSlotType:                       {Name: FestivalSlotType},
+	FictionalCharacterSlotType:             {Name: FictionalCharacterSlotType},
+	FinancialServiceSlotType:               {Name: FinancialServiceSlotType},
+	FirstNameSlotType:                      {Name: FirstNameSlotType},
+	FoodSlotType:                           {Name: FoodSlotType},
+	FoodEstablishmentSlotType:              {Name: FoodEstablishmentSlotType},
+	GameSlotType:                           {Name: GameSlotType},
+	GBCitySlotType:                         {Name: GBCitySlotType, Locales: []string{"en-GB"}},
+	GBFirstNameSlotType:                    {Name: GBFirstNameSlotType, Locales: []string{"en-GB"}},
+	GBRegionSlotType:                       {Name: GBRegionSlotType, Locales: []string{"en-GB"}},
+	GenreSlotType:                          {Name: GenreSlotType},
+	LandformSlotType:                       {Name: LandformSlotType},
+	LandmarksOrHistoricalBuildingsSlotType: {Name: LandmarksOrHistoricalBuildingsSlotType},
+	LanguageSlotType:                       {Name: LanguageSlotType},
+	LocalBusinessSlotType:                  {Name: LocalBusinessSlotType},
+	LocalBusinessTypeSlotType:              {Name: LocalBusinessTypeSlotType},
+	MedicalOrganizationSlotType:            {Name: MedicalOrganizationSlotType},
+	MonthSlotType:                          {Name: MonthSlotType},
+	MovieSlotType:                          {Name: MovieSlotType},
+	MovieSeriesSlotType:                    {Name: MovieSeriesSlotType},
+	MovieTheaterSlotType:                   {Name: MovieTheaterSlotType},
+	MusicAlbumSlotType:                     {Name: MusicAlbumSlotType},
+	MusicCreativeWorkTypeSlotType:          {Name: MusicCreativeWorkTypeSlotType},
+	MusicEventSlotType:                     {Name: MusicEventSlotType},
+	MusicGroupSlotType:                     {Name: MusicGroupSlotType},
+	MusicianSlotType:                       {Name: MusicianSlotType},
+	MusicPlaylistSlotType:                  {Name: MusicPlaylistSlotType},
+	MusicRecordingSlotType:                 {Name: MusicRecordingSlotType},
+	MusicVenueSlotType:                     {Name: MusicVenueSlotType},
+	MusicVideoSlotType:                     {Name: MusicVideoSlotType},
+	OrganizationSlotType:                   {Name: OrganizationSlotType},
+	PersonSlotType:                         {Name: PersonSlotType},
+	PostalAddressSlotType:                  {Name: PostalAddressSlotType},
+	ProfessionalSlotType:                   {Name: ProfessionalSlotType},
+	ProfessionalTypeSlotType:               {Name: ProfessionalTypeSlotType},
+	RadioChannelSlotType:                   {Name: RadioChannelSlotType},
+	RegionSlotType:                         {Name: RegionSlotType},
+	RelativePositionSlotType:               {Name: RelativePositionSlotType},
+	ResidenceSlotType:                      {Name: ResidenceSlotType},
+	RoomSlotType:                           {Name: RoomSlotType},
+	ScreeningEventSlotType:                 {Name: ScreeningEventSlotType},
+	ServiceSlotType:                        {Name: ServiceSlotType},
+	SocialMediaPlatformSlotType:            {Name: SocialMediaPlatformSlotType},
+	SoftwareApplicationSlotType:            {Name: SoftwareApplicationSlotType},
+	SoftwareGameSlotType:                   {Name: SoftwareGameSlotType},
+	SportsEventSlotType:                    {Name: SportsEventSlotType},
+	SportsTeamSlotType:                     {Name: SportsTeamSlotType},
+	StreetAddressSlotType:                  {Name: StreetAddressSlotType},
+	StreetNameSlotType:                     {Name: StreetNameSlotType},
+	TelevisionChannelSlotType:              {Name: TelevisionChannelSlotType},
+	TVEpisodeSlotType:                      {Name: TVEpisodeSlotType},
+	TVSeasonSlotType:                       {Name: TVSeasonSlotType},
+	TVSeriesSlotType:                       {Name: TVSeriesSlotType},
+	USCitySlotType:                         {Name: USCitySlotType, Locales: []string{"en", "de-DE"}},
+	USFirstNameSlotType:                    {Name: USFirstNameSlotType, Locales: []string{"en", "de-DE"}},
+	USStateSlotType:                        {Name: USStateSlotType, Locales: []string{"en", "de-DE", "es", "fr", "it-IT", "ja-JP", "pt-BR"}},
+	VideoGameSlotType:                      {Name: VideoGameSlotType},
+	VisualModeTriggerSlotType:              {Name: VisualModeTriggerSlotType},
+	WeatherConditionSlotType:               {Name: WeatherConditionSlotType},
+	WrittenCreativeWorkTypeSlotType:        {Name: WrittenCreativeWorkTypeSlotType},
+}
@@ -0,0 +1,128 @@
+package alexa
+
+import "encoding/json"
+
+// Names of the interfaces a device may support, as keys of Device.SupportedInterfaces
+const (
+	InterfaceAudioPlayer = "AudioPlayer"
+	InterfaceDisplay     = "Display"
+	InterfaceVideoApp    = "VideoApp"
+	InterfaceAPL         = "Alexa.Presentation.APL"
+	InterfaceAPLT        = "Alexa.Presentation.APLT"
+	InterfaceAPLA        = "Alexa.Presentation.APLA"
+	InterfaceGeolocation = "Geolocation"
+)
+
+// SupportedInterfaces lists the interfaces of the device, a nil field being an interface
+// the device does not support.  Interfaces without a field are kept in Other.
+type SupportedInterfaces struct {
+	AudioPlayer *AudioPlayerInterface
+	Display     *DisplayInterface
+	VideoApp    *VideoAppInterface
+	APL         *APLInterface
+	APLT        *APLInterface
+	APLA        *APLInterface
+	Geolocation *GeolocationInterface
+
+	Other map[string]json.RawMessage
+}
+
+// AudioPlayerInterface is present when the device can stream audio
+type AudioPlayerInterface struct{}
+
+// DisplayInterface is present when the device can render display templates
+type DisplayInterface struct {
+	TemplateVersion string `json:"templateVersion,omitempty"`
+	MarkupVersion   string `json:"markupVersion,omitempty"`
+}
+
+// VideoAppInterface is present when the device can play video
+type VideoAppInterface struct{}
+
+// APLInterface is present when the device renders APL, APLT or APLA documents
+type APLInterface struct {
+	Runtime APLRuntime `json:"runtime"`
+}
+
+// APLRuntime is the highest document version the device supports
+type APLRuntime struct {
+	MaxVersion string `json:"maxVersion"`
+}
+
+// GeolocationInterface is present when the device can share its location
+type GeolocationInterface struct{}
+
+// Supports reports whether the device supports the interface, one of the Interface
+// constants or the name of any other interface
+func (s SupportedInterfaces) Supports(name string) bool {
+	switch name {
+	case InterfaceAudioPlayer:
+		return s.AudioPlayer != nil
+	case InterfaceDisplay:
+		return s.Display != nil
+	case InterfaceVideoApp:
+		return s.VideoApp != nil
+	case InterfaceAPL:
+		return s.APL != nil
+	case InterfaceAPLT:
+		return s.APLT != nil
+	case InterfaceAPLA:
+		return s.APLA != nil
+	case InterfaceGeolocation:
+		return s.Geolocation != nil
+	}
+	_, ok := s.Other[name]
+	return ok
+}
+
+// fields maps the interface names to the typed fields
+func (s *SupportedInterfaces) fields() map[string]interface{} {
+	return map[string]interface{}{
+		InterfaceAudioPlayer: &s.AudioPlayer,
+		InterfaceDisplay:     &s.Display,
+		InterfaceVideoApp:    &s.VideoApp,
+		InterfaceAPL:         &s.APL,
+		InterfaceAPLT:        &s.APLT,
+		InterfaceAPLA:        &s.APLA,
+		InterfaceGeolocation: &s.Geolocation,
+	}
+}
+
+// UnmarshalJSON fills the typed fields and keeps the other interfaces
+func (s *SupportedInterfaces) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*s = SupportedInterfaces{}
+	fields := s.fields()
+	for name, value := range raw {
+		field, ok := fields[name]
+		if !ok {
+			if s.Other == nil {
+				s.Other = map[string]json.RawMessage{}
+			}
+			s.Other[name] = value
+			continue
+		}
+		if err := json.Unmarshal(value, field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalJSON writes the supported interfaces back as Alexa sends them
+func (s SupportedInterfaces) MarshalJSON() ([]byte, error) {
+	out := map[string]interface{}{}
+	for name, value := range s.Other {
+		out[name] = value
+	}
+	for name, field := range s.fields() {
+		if s.Supports(name) {
+			out[name] = field
+		}
+	}
+	return json.Marshal(out)
+}
@@ -0,0 +1,97 @@
+package alexa_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/spirilis/askgo/alexa"
+	"github.com/stretchr/testify/require"
+)
+
+const contextJSON = `{
+	"System": {
+		"device": {
+			"deviceId": "amzn1.ask.device.1",
+			"supportedInterfaces": {
+				"AudioPlayer": {},
+				"Display": {"templateVersion": "1.0", "markupVersion": "1.0"},
+				"Alexa.Presentation.APL": {"runtime": {"maxVersion": "2023.2"}},
+				"Alexa.Presentation.APLT": {"runtime": {"maxVersion": "1.0"}},
+				"Custom.Interface": {"version": 2}
+			}
+		}
+	},
+	"Viewport": {
+		"experiences": [{"arcMinuteWidth": 246, "arcMinuteHeight": 144, "canRotate": false, "canResize": false}],
+		"mode": "HUB",
+		"shape": "RECTANGLE",
+		"pixelWidth": 1280,
+		"pixelHeight": 800,
+		"dpi": 160,
+		"currentPixelWidth": 1280,
+		"currentPixelHeight": 800,
+		"touch": ["SINGLE"],
+		"video": {"codecs": ["H_264_42", "H_264_41"]}
+	},
+	"Viewports": [
+		{"type": "APL", "id": "main", "shape": "RECTANGLE", "dpi": 160, "presentationType": "STANDARD",
+			"configuration": {"current": {"mode": "HUB", "size": {"type": "DISCRETE", "pixelWidth": 1280, "pixelHeight": 800}}}},
+		{"type": "APLT", "id": "clock", "supportedProfiles": ["FOUR_CHARACTER_CLOCK"], "lineLength": 4, "lineCount": 1,
+			"characterFormat": "SEVEN_SEGMENT", "inter_segments": [{"x": 2, "y": 0, "characters": "':."}]}
+	]
+}`
+
+func Test_SupportedInterfaces(t *testing.T) {
+	var ctx alexa.Context
+	require.NoError(t, json.Unmarshal([]byte(contextJSON), &ctx))
+
+	interfaces := ctx.System.Device.SupportedInterfaces
+	require.True(t, interfaces.Supports(alexa.InterfaceAudioPlayer))
+	require.True(t, interfaces.Supports(alexa.InterfaceDisplay))
+	require.True(t, interfaces.Supports("Custom.Interface"))
+	require.False(t, interfaces.Supports(alexa.InterfaceVideoApp))
+	require.False(t, interfaces.Supports(alexa.InterfaceAPLA))
+	require.Equal(t, "2023.2", interfaces.APL.Runtime.MaxVersion)
+	require.Equal(t, "1.0", interfaces.Display.TemplateVersion)
+
+	data, err := json.Marshal(interfaces)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"AudioPlayer": {},
+		"Display": {"templateVersion": "1.0", "markupVersion": "1.0"},
+		"Alexa.Presentation.APL": {"runtime": {"maxVersion": "2023.2"}},
+		"Alexa.Presentation.APLT": {"runtime": {"maxVersion": "1.0"}},
+		"Custom.Interface": {"version": 2}
+	}`, string(data))
+
+	require.Equal(t, alexa.ModeHub, ctx.Viewport.Mode)
+	require.True(t, ctx.Viewport.HasTouch())
+	require.False(t, ctx.Viewport.HasKeyboard())
+	require.Equal(t, alexa.HubLandscapeLarge, ctx.ViewportProfile())
+	require.Len(t, ctx.Viewports, 2)
+	require.Equal(t, 800, ctx.Viewports[0].Configuration.Current.Size.PixelHeight)
+	require.Equal(t, "':.", ctx.Viewports[1].InterSegments[0].Characters)
+
+	require.Equal(t, alexa.UnknownViewport, alexa.Context{}.ViewportProfile())
+}
+
+func Test_ViewportProfile(t *testing.T) {
+	for _, test := range []struct {
+		shape         alexa.ViewportShape
+		width, height int
+		dpi           int
+		profile       alexa.ViewportProfile
+	}{
+		{alexa.ViewportRound, 480, 480, 160, alexa.HubRoundSmall},
+		{alexa.ViewportRectangle, 960, 480, 160, alexa.HubLandscapeSmall},
+		{alexa.ViewportRectangle, 1024, 600, 160, alexa.HubLandscapeMedium},
+		{alexa.ViewportRectangle, 1280, 800, 160, alexa.HubLandscapeLarge},
+		{alexa.ViewportRectangle, 1920, 1080, 320, alexa.TVLandscapeXLarge},
+		{alexa.ViewportRectangle, 1280, 800, 240, alexa.MobileLandscapeLarge},
+		{alexa.ViewportRectangle, 600, 1024, 240, alexa.MobilePortraitLarge},
+		{alexa.ViewportRectangle, 600, 600, 160, alexa.UnknownViewport},
+	} {
+		viewport := &alexa.Viewport{Shape: test.shape, PixelWidth: test.width, PixelHeight: test.height, DPI: test.dpi}
+		require.Equal(t, test.profile, viewport.Profile(), "%dx%d@%d", test.width, test.height, test.dpi)
+	}
+}
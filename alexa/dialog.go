@@ -40,3 +40,46 @@ type DialogConfirmIntentDirective struct {
 	Type          string  `json:"type"`
 	UpdatedIntent *Intent `json:"updatedIntent,omitempty"`
 }
+
+// DialogState is the state of a multi-turn dialog, sent in Request.DialogState
+type DialogState string
+
+// Dialog states
+const (
+	DialogStarted    DialogState = "STARTED"
+	DialogInProgress DialogState = "IN_PROGRESS"
+	DialogCompleted  DialogState = "COMPLETED"
+)
+
+// ConfirmationStatus tells whether the user confirmed or denied an intent or slot
+type ConfirmationStatus string
+
+// Confirmation statuses
+const (
+	ConfirmationNone ConfirmationStatus = "NONE"
+	Confirmed        ConfirmationStatus = "CONFIRMED"
+	Denied           ConfirmationStatus = "DENIED"
+)
+
+// Updated returns a copy of the intent to send back as the updatedIntent of a Dialog
+// directive.  Slot values and confirmation statuses are kept, entity resolutions are
+// left out as Alexa resolves the values again.
+func (i Intent) Updated() *Intent {
+	updated := &Intent{Name: i.Name, ConfirmationStatus: i.ConfirmationStatus, Slots: map[string]IntentSlot{}}
+	for name, slot := range i.Slots {
+		slot.Resolutions = nil
+		if slot.SlotValue != nil {
+			slot.SlotValue = slot.SlotValue.withoutResolutions()
+		}
+		updated.Slots[name] = slot
+	}
+	return updated
+}
+
+func (v *SlotValue) withoutResolutions() *SlotValue {
+	out := &SlotValue{Type: v.Type, Value: v.Value}
+	for _, item := range v.Values {
+		out.Values = append(out.Values, *item.withoutResolutions())
+	}
+	return out
+}
@@ -0,0 +1,48 @@
+package alexa
+
+// Update behaviors of a Dialog.UpdateDynamicEntities directive
+const (
+	// DynamicEntitiesReplace replaces the dynamic entities of the session
+	DynamicEntitiesReplace = "REPLACE"
+	// DynamicEntitiesClear removes every dynamic entity of the session
+	DynamicEntitiesClear = "CLEAR"
+)
+
+// DialogUpdateDynamicEntitiesDirective adds values to the slot types of the interaction
+// model for the rest of the session, or clears them.  Dynamic entities bias recognition
+// towards the values and resolve under a DynamicAuthorityPrefix authority.  Unlike the
+// other Dialog directives it may answer any request.
+type DialogUpdateDynamicEntitiesDirective struct {
+	Type           string           `json:"type"`
+	UpdateBehavior string           `json:"updateBehavior"`
+	Types          []EntityListItem `json:"types,omitempty"`
+}
+
+// EntityListItem holds the dynamic values of one slot type
+type EntityListItem struct {
+	// Name is the name of the custom slot type the values are added to
+	Name   string        `json:"name"`
+	Values []EntityValue `json:"values"`
+}
+
+// EntityValue is a dynamic slot type value
+type EntityValue struct {
+	ID   string          `json:"id,omitempty"`
+	Name EntityValueName `json:"name"`
+}
+
+// EntityValueName is the canonical value and the synonyms of a dynamic entity
+type EntityValueName struct {
+	Value    string   `json:"value"`
+	Synonyms []string `json:"synonyms,omitempty"`
+}
+
+// NewEntity returns a dynamic slot type value with its ID and synonyms
+func NewEntity(id, value string, synonyms ...string) EntityValue {
+	return EntityValue{ID: id, Name: EntityValueName{Value: value, Synonyms: synonyms}}
+}
+
+// NewEntityList returns the dynamic values of a slot type
+func NewEntityList(slotType string, values ...EntityValue) EntityListItem {
+	return EntityListItem{Name: slotType, Values: values}
+}
@@ -1,16 +1,42 @@
 package alexa
 
-var (
-	// StartOverIntent is AMAZON.StartOverIntent
-	StartOverIntent = "AMAZON.StartOverIntent"
-	// CancelIntent is AMAZON.CancelIntent
-	CancelIntent = "AMAZON.CancelIntent"
-	// PauseIntent is AMAZON.PauseIntent
-	PauseIntent = "AMAZON.PauseIntent"
-	// HelpIntent is AMAZON.HelpIntent
-	HelpIntent = "AMAZON.HelpIntent"
-	// StopIntent is AMAZON.StopIntent
-	StopIntent = "AMAZON.StopIntent"
-	// RepeatIntent is AMAZON.RepeatIntent
-	RepeatIntent = "AMAZON.RepeatIntent"
-)
+import "strings"
+
+//go:generate go run ../tools/builtin-gen -o builtins.go builtins.yaml
+
+// BuiltIn describes an Amazon built-in intent or slot type
+type BuiltIn struct {
+	Name string
+	// Locales supporting the built-in, a language such as "en" covers all of its
+	// locales.  Empty when every locale supports it.
+	Locales []string
+}
+
+// Supports reports whether the built-in is available in the locale
+func (b BuiltIn) Supports(locale string) bool {
+	if len(b.Locales) == 0 {
+		return true
+	}
+	language := locale
+	if n := strings.IndexAny(locale, "-_"); n > 0 {
+		language = locale[:n]
+	}
+	for _, l := range b.Locales {
+		if strings.EqualFold(l, locale) || strings.EqualFold(l, language) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsBuiltInIntent reports whether name is an Amazon built-in intent
+func IsBuiltInIntent(name string) bool {
+	_, ok := BuiltInIntents[name]
+	return ok
+}
+
+// IsBuiltInSlotType reports whether name is an Amazon built-in slot type
+func IsBuiltInSlotType(name string) bool {
+	_, ok := BuiltInSlotTypes[name]
+	return ok
+}
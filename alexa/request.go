@@ -1,10 +1,5 @@
 package alexa
 
-import (
-	"log"
-	"reflect"
-)
-
 // RequestEnvelope is the deserialized http post request sent by alexa.
 type RequestEnvelope struct {
 	Version string  `json:"version"`
@@ -38,6 +33,17 @@ type User struct {
 type Context struct {
 	System      System      `json:"System"`
 	AudioPlayer AudioPlayer `json:"audioPlayer"`
+	// Viewport is the screen of the device, nil for devices without one
+	Viewport *Viewport `json:"Viewport,omitempty"`
+	// Viewports lists the APL screens and APLT character displays of the device
+	Viewports []ViewportDescriptor `json:"Viewports,omitempty"`
+	// APL is the APL document on the screen, if any
+	APL *APLVisualContext `json:"Alexa.Presentation.APL,omitempty"`
+}
+
+// ViewportProfile classifies the screen of the device, see Viewport.Profile
+func (c Context) ViewportProfile() ViewportProfile {
+	return c.Viewport.Profile()
 }
 
 // System object that provides information about the current state of the Alexa service and the device interacting with your skill.
@@ -51,8 +57,8 @@ type System struct {
 
 // Device object providing information about the device used to send the request.
 type Device struct {
-	DeviceID            string                 `json:"deviceId"`
-	SupportedInterfaces map[string]interface{} `json:"supportedInterfaces"`
+	DeviceID            string              `json:"deviceId"`
+	SupportedInterfaces SupportedInterfaces `json:"supportedInterfaces"`
 }
 
 // AudioPlayer object providing the current state for the AudioPlayer interface.
@@ -72,8 +78,8 @@ type Request struct {
 	Session *Session `json:"session,omitempty"`
 	Context *Context `json:"context,omitempty"`
 	// Intent Requests
-	Intent      Intent `json:"intent,omitempty"`
-	DialogState string `json:"dialogState,omitempty"`
+	Intent      Intent      `json:"intent,omitempty"`
+	DialogState DialogState `json:"dialogState,omitempty"`
 	// SessionEndRequest
 	Reason string `json:"reason,omitempty"`
 	// SessionEndRequest, SystemExceptionEncounteredRequest
@@ -92,6 +98,12 @@ type Request struct {
 	Token                string `json:"token"`
 	OffsetInMilliseconds int    `json:"offsetInMilliseconds"`
 
+	// Alexa.Presentation.APL.UserEvent is sent by the SendEvent command of the document
+	// with the Token above, with the arguments of the command and the component sending it
+	Arguments  []interface{}          `json:"arguments,omitempty"`
+	Source     *APLEventSource        `json:"source,omitempty"`
+	Components map[string]interface{} `json:"components,omitempty"`
+
 	// AudioPlayerPlaybackFailedRequest is sent when Alexa encounters an error when attempting to play a stream.
 	CurrentPlaybackState struct {
 		Token                string `json:"token"`
@@ -104,15 +116,18 @@ type Request struct {
 type Intent struct {
 	Name               string                `json:"name,omitempty"`
 	Slots              map[string]IntentSlot `json:"slots,omitempty"`
-	ConfirmationStatus string                `json:"confirmationStatus,omitempty"`
+	ConfirmationStatus ConfirmationStatus    `json:"confirmationStatus,omitempty"`
 }
 
 // IntentSlot is provided in Intents
 type IntentSlot struct {
-	Name               string      `json:"name"`
-	Value              string      `json:"value"`
-	ConfirmationStatus string      `json:"confirmationStatus,omitempty"`
-	Resolutions        interface{} `json:"resolutions"`
+	Name               string             `json:"name"`
+	Value              string             `json:"value,omitempty"`
+	ConfirmationStatus ConfirmationStatus `json:"confirmationStatus,omitempty"`
+	Resolutions        *Resolutions       `json:"resolutions,omitempty"`
+	// SlotValue is sent along with Value and Resolutions, and is the only place the
+	// values of a multiple-value slot are found
+	SlotValue *SlotValue `json:"slotValue,omitempty"`
 }
 
 const (
@@ -120,42 +135,34 @@ const (
 	SlotValueFound
 	SlotValueNotFound
 	SlotValueUnverified
+	SlotValueTimeout
+	SlotValueError
 )
 
 type SlotResolutionStatus uint
 
-// This function uses Reflection to walk the JSON structure of Resolutions, an interface{}
-// Looks for .resolutionsPerAuthority[0].status.code
+// SlotValueResolution summarizes the entity resolution of the slot over every authority:
+// found when any authority matched, not found when they all reported no match, and
+// unverified when Alexa sent no resolutions.  Otherwise the timeout or error of the
+// first authority that failed is returned.
 func (i IntentSlot) SlotValueResolution() SlotResolutionStatus {
-	//inspect_rec([]string{}, reflect.ValueOf(i.Resolutions))
-	resPerAuth := reflect.ValueOf(i.Resolutions)
-	if resPerAuth.Kind() != reflect.Map {
-		log.Printf("Resolutions isn't a map [%d]", resPerAuth.Kind())
+	if i.Resolutions == nil || len(i.Resolutions.ResolutionsPerAuthority) == 0 {
 		return SlotResolutionStatus(SlotValueUnverified)
 	}
-	rpaArray := reflect.ValueOf(resPerAuth.MapIndex(reflect.ValueOf("resolutionsPerAuthority")).Interface())
-	if rpaArray.Kind() != reflect.Slice {
-		log.Printf("Resolutions[resolutionsPerAuthority] isn't a slice [%d]", rpaArray.Kind())
-	}
-	rpa0 := reflect.ValueOf(rpaArray.Index(0).Interface())
-	if rpa0.Kind() != reflect.Map {
-		log.Printf("Resolutions[resolutionsPerAuthority][0] isn't a map [%d]", rpa0.Kind())
-		return SlotResolutionStatus(SlotValueUnknown)
-	}
-	status := reflect.ValueOf(rpa0.MapIndex(reflect.ValueOf("status")).Interface())
-	if status.Kind() != reflect.Map {
-		log.Printf("Resolutions[resolutionsPerAuthority][0].status isn't a map [%d]", status.Kind())
-		return SlotResolutionStatus(SlotValueUnknown)
-	}
-	code := reflect.ValueOf(status.MapIndex(reflect.ValueOf("code")).Interface())
-	if code.Kind() != reflect.String {
-		log.Printf("Resolutions[resolutionsPerAuthority][0].status.code isn't a string [%d]", code.Kind())
-		return SlotResolutionStatus(SlotValueUnknown)
-	}
-	statusCode := code.String()
-	log.Printf("Status Code: %s", statusCode)
 
-	return SlotResolutionStatusCode(statusCode)
+	status := SlotResolutionStatus(SlotValueNotFound)
+	for _, r := range i.Resolutions.ResolutionsPerAuthority {
+		switch code := SlotResolutionStatusCode(r.Status.Code); code {
+		case SlotResolutionStatus(SlotValueFound):
+			return code
+		case SlotResolutionStatus(SlotValueNotFound):
+		default:
+			if status == SlotResolutionStatus(SlotValueNotFound) {
+				status = code
+			}
+		}
+	}
+	return status
 }
 
 // Check the slot value resolution is ER_SUCCESS_MATCH, or we don't know (Alexa validated it before sending us the request)
@@ -166,10 +173,14 @@ func (i IntentSlot) IsSlotValidValue() bool {
 
 func SlotResolutionStatusCode(val string) SlotResolutionStatus {
 	switch val {
-	case "ER_SUCCESS_NO_MATCH":
+	case ResolutionNoMatch:
 		return SlotResolutionStatus(SlotValueNotFound)
-	case "ER_SUCCESS_MATCH":
+	case ResolutionMatch:
 		return SlotResolutionStatus(SlotValueFound)
+	case ResolutionTimeout:
+		return SlotResolutionStatus(SlotValueTimeout)
+	case ResolutionException:
+		return SlotResolutionStatus(SlotValueError)
 	default:
 		return SlotResolutionStatus(SlotValueUnknown)
 	}
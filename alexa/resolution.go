@@ -0,0 +1,138 @@
+package alexa
+
+import "strings"
+
+// Entity resolution status codes found in ResolutionStatus.Code
+const (
+	ResolutionMatch     = "ER_SUCCESS_MATCH"
+	ResolutionNoMatch   = "ER_SUCCESS_NO_MATCH"
+	ResolutionTimeout   = "ER_ERROR_TIMEOUT"
+	ResolutionException = "ER_ERROR_EXCEPTION"
+)
+
+// DynamicAuthorityPrefix starts the authority of entities added with a Dialog.UpdateDynamicEntities
+// directive, static slot type values use an amzn1.er-authority.echo-sdk.<skill id>.<type> authority
+const DynamicAuthorityPrefix = "amzn1.er-authority.echo-sdk.dynamic."
+
+// Resolutions holds the entity resolution results of a slot, one per authority
+type Resolutions struct {
+	ResolutionsPerAuthority []Resolution `json:"resolutionsPerAuthority"`
+}
+
+// Resolution is the result of resolving a slot value against one authority
+type Resolution struct {
+	Authority string           `json:"authority"`
+	Status    ResolutionStatus `json:"status"`
+	Values    []ResolutionItem `json:"values,omitempty"`
+}
+
+// ResolutionStatus reports whether the authority matched the slot value
+type ResolutionStatus struct {
+	Code string `json:"code"`
+}
+
+// ResolutionItem wraps a resolved value
+type ResolutionItem struct {
+	Value ResolvedValue `json:"value"`
+}
+
+// ResolvedValue is the canonical name and ID of a slot type value
+type ResolvedValue struct {
+	Name string `json:"name"`
+	ID   string `json:"id,omitempty"`
+	// Authority the value was resolved by, filled in by AllMatches
+	Authority string `json:"-"`
+}
+
+// IsDynamic reports whether the value was resolved by dynamic entities rather than the
+// values of the slot type in the interaction model
+func (v ResolvedValue) IsDynamic() bool {
+	return strings.HasPrefix(v.Authority, DynamicAuthorityPrefix)
+}
+
+// IsDynamic reports whether the authority holds dynamic entities
+func (r Resolution) IsDynamic() bool {
+	return strings.HasPrefix(r.Authority, DynamicAuthorityPrefix)
+}
+
+// Matched reports whether the authority resolved the value
+func (r Resolution) Matched() bool {
+	return r.Status.Code == ResolutionMatch
+}
+
+// AllMatches returns every value resolved by the authorities, dynamic entities first and
+// then static values, each in the order Alexa ranked them.  Values repeated by several
+// authorities are listed once.
+func (r *Resolutions) AllMatches() []ResolvedValue {
+	if r == nil {
+		return nil
+	}
+
+	var matches []ResolvedValue
+	seen := map[ResolvedValue]bool{}
+	for _, dynamic := range []bool{true, false} {
+		for _, res := range r.ResolutionsPerAuthority {
+			if res.IsDynamic() != dynamic || !res.Matched() {
+				continue
+			}
+			for _, item := range res.Values {
+				key := ResolvedValue{Name: item.Value.Name, ID: item.Value.ID}
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+				key.Authority = res.Authority
+				matches = append(matches, key)
+			}
+		}
+	}
+	return matches
+}
+
+// AllMatches returns every value the slot resolved to, see Resolutions.AllMatches
+func (i IntentSlot) AllMatches() []ResolvedValue {
+	return i.Resolutions.AllMatches()
+}
+
+// IsAmbiguous reports whether the slot resolved to more than one value, in which case
+// the user should be asked which one they meant
+func (i IntentSlot) IsAmbiguous() bool {
+	return len(i.AllMatches()) > 1
+}
+
+// CanonicalValue returns the name of the best resolved value, preferring dynamic
+// entities, or the value as spoken when nothing matched
+func (i IntentSlot) CanonicalValue() string {
+	return canonicalValue(i.Resolutions, i.Value)
+}
+
+// ResolvedID returns the ID of the best resolved value, preferring dynamic entities, or
+// "" when nothing matched
+func (i IntentSlot) ResolvedID() string {
+	return resolvedID(i.Resolutions)
+}
+
+// IsDynamicMatch reports whether the best resolved value comes from the dynamic entities
+// of the session
+func (i IntentSlot) IsDynamicMatch() bool {
+	return isDynamicMatch(i.Resolutions)
+}
+
+func isDynamicMatch(r *Resolutions) bool {
+	matches := r.AllMatches()
+	return len(matches) > 0 && matches[0].IsDynamic()
+}
+
+func canonicalValue(r *Resolutions, spoken string) string {
+	if matches := r.AllMatches(); len(matches) > 0 {
+		return matches[0].Name
+	}
+	return spoken
+}
+
+func resolvedID(r *Resolutions) string {
+	if matches := r.AllMatches(); len(matches) > 0 {
+		return matches[0].ID
+	}
+	return ""
+}
@@ -0,0 +1,74 @@
+package alexa_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/spirilis/askgo/alexa"
+	"github.com/stretchr/testify/require"
+)
+
+const slotJSON = `{
+	"name": "Drink",
+	"value": "java",
+	"resolutions": {
+		"resolutionsPerAuthority": [
+			{
+				"authority": "amzn1.er-authority.echo-sdk.amzn1.ask.skill.xyzzy.DRINK",
+				"status": {"code": "ER_SUCCESS_MATCH"},
+				"values": [
+					{"value": {"name": "coffee", "id": "COFFEE"}},
+					{"value": {"name": "house blend", "id": "HOUSE"}}
+				]
+			},
+			{
+				"authority": "amzn1.er-authority.echo-sdk.dynamic.amzn1.ask.skill.xyzzy.DRINK",
+				"status": {"code": "ER_SUCCESS_MATCH"},
+				"values": [
+					{"value": {"name": "house blend", "id": "HOUSE"}}
+				]
+			}
+		]
+	}
+}`
+
+func Test_Resolutions(t *testing.T) {
+	var slot alexa.IntentSlot
+	require.NoError(t, json.Unmarshal([]byte(slotJSON), &slot))
+
+	require.Equal(t, "house blend", slot.CanonicalValue())
+	require.Equal(t, "HOUSE", slot.ResolvedID())
+	require.True(t, slot.IsAmbiguous())
+
+	matches := slot.AllMatches()
+	require.Len(t, matches, 2)
+	require.Equal(t, "COFFEE", matches[1].ID)
+	require.Contains(t, matches[0].Authority, "dynamic")
+	require.True(t, matches[0].IsDynamic())
+	require.False(t, matches[1].IsDynamic())
+	require.True(t, slot.IsDynamicMatch())
+	require.Equal(t, alexa.SlotResolutionStatus(alexa.SlotValueFound), slot.SlotValueResolution())
+}
+
+func Test_ResolutionStatus(t *testing.T) {
+	slot := alexa.IntentSlot{Value: "tea"}
+	require.Equal(t, alexa.SlotResolutionStatus(alexa.SlotValueUnverified), slot.SlotValueResolution())
+	require.True(t, slot.IsSlotValidValue())
+	require.Equal(t, "tea", slot.CanonicalValue())
+	require.Equal(t, "", slot.ResolvedID())
+
+	slot.Resolutions = &alexa.Resolutions{}
+	require.Equal(t, alexa.SlotResolutionStatus(alexa.SlotValueUnverified), slot.SlotValueResolution())
+
+	slot.Resolutions.ResolutionsPerAuthority = []alexa.Resolution{
+		{Authority: "static", Status: alexa.ResolutionStatus{Code: alexa.ResolutionNoMatch}},
+		{Authority: alexa.DynamicAuthorityPrefix + "skill", Status: alexa.ResolutionStatus{Code: alexa.ResolutionTimeout}},
+	}
+	require.Equal(t, alexa.SlotResolutionStatus(alexa.SlotValueTimeout), slot.SlotValueResolution())
+	require.False(t, slot.IsSlotValidValue())
+	require.Empty(t, slot.AllMatches())
+	require.False(t, slot.IsDynamicMatch())
+
+	slot.Resolutions.ResolutionsPerAuthority = slot.Resolutions.ResolutionsPerAuthority[:1]
+	require.Equal(t, alexa.SlotResolutionStatus(alexa.SlotValueNotFound), slot.SlotValueResolution())
+}
@@ -0,0 +1,98 @@
+package alexa
+
+// Types of SlotValue
+const (
+	SimpleSlotValue = "Simple"
+	ListSlotValue   = "List"
+)
+
+// SlotValue is the value of a slot.  A Simple value holds one spoken value and its
+// resolutions, a List value holds the Simple values of a multiple-value slot.
+type SlotValue struct {
+	Type        string       `json:"type"`
+	Value       string       `json:"value,omitempty"`
+	Resolutions *Resolutions `json:"resolutions,omitempty"`
+	Values      []SlotValue  `json:"values,omitempty"`
+}
+
+// AllMatches returns every value the slot value resolved to, see Resolutions.AllMatches
+func (v SlotValue) AllMatches() []ResolvedValue {
+	return v.Resolutions.AllMatches()
+}
+
+// CanonicalValue returns the name of the best resolved value, preferring dynamic
+// entities, or the value as spoken when nothing matched
+func (v SlotValue) CanonicalValue() string {
+	return canonicalValue(v.Resolutions, v.Value)
+}
+
+// ResolvedID returns the ID of the best resolved value, or "" when nothing matched
+func (v SlotValue) ResolvedID() string {
+	return resolvedID(v.Resolutions)
+}
+
+// IsDynamicMatch reports whether the best resolved value comes from the dynamic entities
+// of the session
+func (v SlotValue) IsDynamicMatch() bool {
+	return isDynamicMatch(v.Resolutions)
+}
+
+// IsList reports whether the slot was given several values
+func (i IntentSlot) IsList() bool {
+	return i.SlotValue != nil && i.SlotValue.Type == ListSlotValue
+}
+
+// Values returns every value of the slot as Simple values: the items of a multiple-value
+// slot, or the single value of any other slot.  An empty slot has no values.
+func (i IntentSlot) Values() []SlotValue {
+	switch {
+	case i.SlotValue != nil && i.SlotValue.Type == ListSlotValue:
+		return i.SlotValue.Values
+	case i.SlotValue != nil:
+		return []SlotValue{*i.SlotValue}
+	case i.Value != "":
+		return []SlotValue{{Type: SimpleSlotValue, Value: i.Value, Resolutions: i.Resolutions}}
+	}
+	return nil
+}
+
+// NewIntent returns an intent with no slots, to fill in as the updatedIntent of a Dialog
+// directive
+func NewIntent(name string) *Intent {
+	return &Intent{Name: name, Slots: map[string]IntentSlot{}}
+}
+
+// SetSlot gives a slot of the intent a single value, keeping its confirmation status
+func (i *Intent) SetSlot(name, value string) *Intent {
+	if i.Slots == nil {
+		i.Slots = map[string]IntentSlot{}
+	}
+	slot := i.Slots[name]
+	slot.Name = name
+	slot.Value = value
+	slot.Resolutions = nil
+	slot.SlotValue = nil
+	if value != "" {
+		slot.SlotValue = &SlotValue{Type: SimpleSlotValue, Value: value}
+	}
+	i.Slots[name] = slot
+	return i
+}
+
+// SetSlotValues gives a multiple-value slot of the intent its values, keeping its
+// confirmation status
+func (i *Intent) SetSlotValues(name string, values ...string) *Intent {
+	if i.Slots == nil {
+		i.Slots = map[string]IntentSlot{}
+	}
+	slot := i.Slots[name]
+	slot.Name = name
+	slot.Value = ""
+	slot.Resolutions = nil
+	slot.SlotValue = &SlotValue{Type: ListSlotValue, Values: []SlotValue{}}
+	for _, v := range values {
+		slot.SlotValue.Values = append(slot.SlotValue.Values, SlotValue{Type: SimpleSlotValue, Value: v})
+	}
+	i.Slots[name] = slot
+	return i
+}
@@ -0,0 +1,68 @@
+package alexa_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/spirilis/askgo/alexa"
+	"github.com/stretchr/testify/require"
+)
+
+const listSlotJSON = `{
+	"name": "Item",
+	"confirmationStatus": "NONE",
+	"slotValue": {
+		"type": "List",
+		"values": [
+			{"type": "Simple", "value": "milk"},
+			{
+				"type": "Simple",
+				"value": "hen fruit",
+				"resolutions": {
+					"resolutionsPerAuthority": [{
+						"authority": "amzn1.er-authority.echo-sdk.amzn1.ask.skill.xyzzy.GROCERY",
+						"status": {"code": "ER_SUCCESS_MATCH"},
+						"values": [{"value": {"name": "eggs", "id": "EGGS"}}]
+					}]
+				}
+			},
+			{"type": "Simple", "value": "bread"}
+		]
+	}
+}`
+
+func Test_SlotValues(t *testing.T) {
+	var slot alexa.IntentSlot
+	require.NoError(t, json.Unmarshal([]byte(listSlotJSON), &slot))
+
+	require.True(t, slot.IsList())
+	values := slot.Values()
+	require.Len(t, values, 3)
+	require.Equal(t, "milk", values[0].CanonicalValue())
+	require.Equal(t, "eggs", values[1].CanonicalValue())
+	require.Equal(t, "EGGS", values[1].ResolvedID())
+
+	simple := alexa.IntentSlot{Name: "Item", Value: "milk"}
+	require.False(t, simple.IsList())
+	require.Equal(t, []alexa.SlotValue{{Type: alexa.SimpleSlotValue, Value: "milk"}}, simple.Values())
+	require.Empty(t, alexa.IntentSlot{Name: "Item"}.Values())
+}
+
+func Test_UpdatedIntent(t *testing.T) {
+	intent := alexa.NewIntent("ShoppingIntent").
+		SetSlotValues("Item", "milk", "eggs").
+		SetSlot("Store", "corner shop")
+
+	data, err := json.Marshal(intent)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"name": "ShoppingIntent",
+		"slots": {
+			"Item": {"name": "Item", "slotValue": {"type": "List", "values": [
+				{"type": "Simple", "value": "milk"},
+				{"type": "Simple", "value": "eggs"}
+			]}},
+			"Store": {"name": "Store", "value": "corner shop", "slotValue": {"type": "Simple", "value": "corner shop"}}
+		}
+	}`, string(data))
+}
@@ -0,0 +1,243 @@
+package alexa
+
+// ViewportShape is the shape of a screen
+type ViewportShape string
+
+// Viewport shapes
+const (
+	ViewportRound     ViewportShape = "ROUND"
+	ViewportRectangle ViewportShape = "RECTANGLE"
+)
+
+// ViewportMode is the kind of device the screen belongs to
+type ViewportMode string
+
+// Viewport modes
+const (
+	ModeAuto   ViewportMode = "AUTO"
+	ModeHub    ViewportMode = "HUB"
+	ModeMobile ViewportMode = "MOBILE"
+	ModePC     ViewportMode = "PC"
+	ModeTV     ViewportMode = "TV"
+)
+
+// Touch and keyboard input of a viewport
+const (
+	TouchSingle       = "SINGLE"
+	KeyboardDirection = "DIRECTION"
+)
+
+// Viewport describes the screen of the device, sent as context.Viewport
+type Viewport struct {
+	Experiences        []ViewportExperience `json:"experiences,omitempty"`
+	Mode               ViewportMode         `json:"mode,omitempty"`
+	Shape              ViewportShape        `json:"shape,omitempty"`
+	PixelWidth         int                  `json:"pixelWidth"`
+	PixelHeight        int                  `json:"pixelHeight"`
+	CurrentPixelWidth  int                  `json:"currentPixelWidth,omitempty"`
+	CurrentPixelHeight int                  `json:"currentPixelHeight,omitempty"`
+	DPI                int                  `json:"dpi"`
+	Touch              []string             `json:"touch,omitempty"`
+	Keyboard           []string             `json:"keyboard,omitempty"`
+	Video              *ViewportVideo       `json:"video,omitempty"`
+}
+
+// ViewportExperience is a way the screen may be viewed, with its size in arc minutes
+type ViewportExperience struct {
+	ArcMinuteWidth  float64 `json:"arcMinuteWidth"`
+	ArcMinuteHeight float64 `json:"arcMinuteHeight"`
+	CanRotate       bool    `json:"canRotate"`
+	CanResize       bool    `json:"canResize"`
+}
+
+// ViewportVideo lists the video codecs the screen plays
+type ViewportVideo struct {
+	Codecs []string `json:"codecs"`
+}
+
+// ViewportDescriptor is one of the screens of context.Viewports: an APL screen or an APLT
+// character display
+type ViewportDescriptor struct {
+	Type             string                 `json:"type"`
+	ID               string                 `json:"id,omitempty"`
+	Shape            ViewportShape          `json:"shape,omitempty"`
+	DPI              int                    `json:"dpi,omitempty"`
+	PresentationType string                 `json:"presentationType,omitempty"`
+	CanRotate        bool                   `json:"canRotate,omitempty"`
+	Configuration    *ViewportConfiguration `json:"configuration,omitempty"`
+
+	// APLT character displays
+	SupportedProfiles []string       `json:"supportedProfiles,omitempty"`
+	LineLength        int            `json:"lineLength,omitempty"`
+	LineCount         int            `json:"lineCount,omitempty"`
+	CharacterFormat   string         `json:"characterFormat,omitempty"`
+	InterSegments     []InterSegment `json:"inter_segments,omitempty"`
+}
+
+// ViewportConfiguration is the current mode and size of an APL screen
+type ViewportConfiguration struct {
+	Current struct {
+		Mode  ViewportMode   `json:"mode,omitempty"`
+		Video *ViewportVideo `json:"video,omitempty"`
+		Size  ViewportSize   `json:"size"`
+	} `json:"current"`
+}
+
+// ViewportSize is the size of an APL screen in pixels
+type ViewportSize struct {
+	Type        string `json:"type"`
+	PixelWidth  int    `json:"pixelWidth"`
+	PixelHeight int    `json:"pixelHeight"`
+}
+
+// InterSegment is a separator of an APLT character display, such as the colon of a clock
+type InterSegment struct {
+	X          int    `json:"x"`
+	Y          int    `json:"y"`
+	Characters string `json:"characters"`
+}
+
+// Width returns the current width of the screen in pixels
+func (v *Viewport) Width() int {
+	if v.CurrentPixelWidth > 0 {
+		return v.CurrentPixelWidth
+	}
+	return v.PixelWidth
+}
+
+// Height returns the current height of the screen in pixels
+func (v *Viewport) Height() int {
+	if v.CurrentPixelHeight > 0 {
+		return v.CurrentPixelHeight
+	}
+	return v.PixelHeight
+}
+
+// HasTouch reports whether the screen accepts touch input
+func (v *Viewport) HasTouch() bool {
+	return len(v.Touch) > 0
+}
+
+// HasKeyboard reports whether the device has a keyboard or remote with arrow keys
+func (v *Viewport) HasKeyboard() bool {
+	return len(v.Keyboard) > 0
+}
+
+// ViewportProfile is a class of screens that look alike, to pick a layout for
+type ViewportProfile string
+
+// Viewport profiles
+const (
+	HubRoundSmall         ViewportProfile = "HUB_ROUND_SMALL"
+	HubLandscapeSmall     ViewportProfile = "HUB_LANDSCAPE_SMALL"
+	HubLandscapeMedium    ViewportProfile = "HUB_LANDSCAPE_MEDIUM"
+	HubLandscapeLarge     ViewportProfile = "HUB_LANDSCAPE_LARGE"
+	MobileLandscapeSmall  ViewportProfile = "MOBILE_LANDSCAPE_SMALL"
+	MobilePortraitSmall   ViewportProfile = "MOBILE_PORTRAIT_SMALL"
+	MobileLandscapeMedium ViewportProfile = "MOBILE_LANDSCAPE_MEDIUM"
+	MobilePortraitMedium  ViewportProfile = "MOBILE_PORTRAIT_MEDIUM"
+	MobileLandscapeLarge  ViewportProfile = "MOBILE_LANDSCAPE_LARGE"
+	MobilePortraitLarge   ViewportProfile = "MOBILE_PORTRAIT_LARGE"
+	TVLandscapeMedium     ViewportProfile = "TV_LANDSCAPE_MEDIUM"
+	TVLandscapeXLarge     ViewportProfile = "TV_LANDSCAPE_XLARGE"
+	TVPortraitMedium      ViewportProfile = "TV_PORTRAIT_MEDIUM"
+	UnknownViewport       ViewportProfile = "UNKNOWN_VIEWPORT_PROFILE"
+)
+
+// size and dpi groups of the profile classification, in increasing order
+const (
+	groupXSmall = iota
+	groupSmall
+	groupMedium
+	groupLarge
+	groupXLarge
+)
+
+const (
+	dpiXLow = iota
+	dpiLow
+	dpiMedium
+	dpiHigh
+	dpiXHigh
+	dpiXXHigh
+)
+
+func sizeGroup(pixels int) int {
+	switch {
+	case pixels < 600:
+		return groupXSmall
+	case pixels < 960:
+		return groupSmall
+	case pixels < 1280:
+		return groupMedium
+	case pixels < 1920:
+		return groupLarge
+	}
+	return groupXLarge
+}
+
+func dpiGroup(dpi int) int {
+	switch {
+	case dpi < 121:
+		return dpiXLow
+	case dpi < 161:
+		return dpiLow
+	case dpi < 241:
+		return dpiMedium
+	case dpi < 321:
+		return dpiHigh
+	case dpi < 481:
+		return dpiXHigh
+	}
+	return dpiXXHigh
+}
+
+// Profile classifies the screen by shape, orientation, size and density, using the same
+// groups as the Alexa Skills Kit SDKs.  A nil viewport, a device without a screen, is
+// UnknownViewport.
+func (v *Viewport) Profile() ViewportProfile {
+	if v == nil {
+		return UnknownViewport
+	}
+	width, height := v.Width(), v.Height()
+	w, h, dpi := sizeGroup(width), sizeGroup(height), dpiGroup(v.DPI)
+	landscape, portrait := width > height, height > width
+
+	if v.Shape == ViewportRound {
+		if width == height && w == groupXSmall && h == groupXSmall {
+			return HubRoundSmall
+		}
+		return UnknownViewport
+	}
+	if v.Shape != ViewportRectangle {
+		return UnknownViewport
+	}
+
+	switch {
+	case landscape && dpi == dpiLow && w <= groupMedium && h <= groupXSmall:
+		return HubLandscapeSmall
+	case landscape && dpi == dpiLow && w <= groupMedium && h <= groupSmall:
+		return HubLandscapeMedium
+	case landscape && dpi == dpiLow && w >= groupLarge && h >= groupSmall:
+		return HubLandscapeLarge
+	case landscape && dpi == dpiMedium && w >= groupMedium && h >= groupSmall:
+		return MobileLandscapeLarge
+	case portrait && dpi == dpiMedium && w >= groupSmall && h >= groupMedium:
+		return MobilePortraitLarge
+	case landscape && dpi == dpiMedium && w >= groupSmall && h >= groupXSmall:
+		return MobileLandscapeMedium
+	case portrait && dpi == dpiMedium && w >= groupXSmall && h >= groupSmall:
+		return MobilePortraitMedium
+	case landscape && dpi == dpiLow && w >= groupXSmall && h >= groupXSmall:
+		return MobileLandscapeSmall
+	case portrait && dpi == dpiLow && w >= groupXSmall && h >= groupXSmall:
+		return MobilePortraitSmall
+	case landscape && dpi >= dpiHigh && w >= groupXLarge && h >= groupMedium:
+		return TVLandscapeXLarge
+	case portrait && dpi >= dpiHigh && w == groupXSmall && h == groupXLarge:
+		return TVPortraitMedium
+	case landscape && dpi >= dpiHigh && w == groupMedium && h == groupSmall:
+		return TVLandscapeMedium
+	}
+	return UnknownViewport
+}
@@ -0,0 +1,249 @@
+package askgo
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spirilis/askgo/alexa"
+	"github.com/spirilis/askgo/slottype"
+)
+
+// ErrNoResolutionMatch is the error of an InvalidSlot bound with the id option when entity
+// resolution matched none of the values of the slot
+var ErrNoResolutionMatch = errors.New("no resolution match")
+
+// InvalidSlot is a slot whose value could not be converted to its field
+type InvalidSlot struct {
+	Slot  string
+	Value string
+	Err   error
+}
+
+// SlotError is returned by BindSlots when required slots are missing or slot values are
+// invalid.  SlotToElicit names the slot to ask the user for next.
+type SlotError struct {
+	Missing []string
+	Invalid []InvalidSlot
+}
+
+func (e *SlotError) Error() string {
+	var msgs []string
+	if len(e.Missing) > 0 {
+		msgs = append(msgs, "missing slots "+strings.Join(e.Missing, ", "))
+	}
+	for _, i := range e.Invalid {
+		msgs = append(msgs, fmt.Sprintf("slot %s value %q: %v", i.Slot, i.Value, i.Err))
+	}
+	return "cannot bind slots: " + strings.Join(msgs, "; ")
+}
+
+// SlotToElicit returns the first missing slot, or the first invalid one when none are
+// missing, ready for AddElicitSlotDirective
+func (e *SlotError) SlotToElicit() string {
+	if len(e.Missing) > 0 {
+		return e.Missing[0]
+	}
+	if len(e.Invalid) > 0 {
+		return e.Invalid[0].Slot
+	}
+	return ""
+}
+
+var (
+	durationType   = reflect.TypeOf(time.Duration(0))
+	dateRangeType  = reflect.TypeOf(slottype.DateRange{})
+	timeRangeType  = reflect.TypeOf(slottype.TimeRange{})
+	intentSlotType = reflect.TypeOf(alexa.IntentSlot{})
+)
+
+// BindSlots fills the fields of the struct pointed to by v from the slots of the intent.
+// Each field to fill is tagged with the slot name:
+//
+//	var slots struct {
+//		Amount float64             `slot:"Amount" default:"0"`
+//		Count  int                 `slot:"TransactionCount" required:"true"`
+//		Drink  string              `slot:"Drink,id"`
+//		Items  []string            `slot:"Item,canonical"`
+//		When   slottype.DateRange  `slot:"When"`
+//	}
+//
+// Strings receive the spoken value, or with the canonical option the resolved name and
+// with the id option the resolved ID; a value without a resolution match is then invalid.  Numbers, bools, time.Duration, slottype.DateRange
+// and slottype.TimeRange are parsed with the slottype parsers, relative values being
+// resolved against now.  A []string receives every value of a multiple-value slot and
+// an alexa.IntentSlot the slot itself.
+//
+// An empty slot takes its default, if any.  Missing required slots and values that fail
+// to parse are all reported in a *SlotError.
+func BindSlots(intent alexa.Intent, now time.Time, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("BindSlots needs a pointer to a struct, not %T", v)
+	}
+	rv = rv.Elem()
+
+	slotErr := &SlotError{}
+	for n := 0; n < rv.NumField(); n++ {
+		field := rv.Type().Field(n)
+		tag, ok := field.Tag.Lookup("slot")
+		if !ok || field.PkgPath != "" {
+			continue
+		}
+		name, option, _ := strings.Cut(tag, ",")
+		slot := intent.Slots[name]
+
+		value := slot.Value
+		switch option {
+		case "canonical":
+			value = slot.CanonicalValue()
+		case "id":
+			value = slot.ResolvedID()
+		}
+
+		empty := len(slot.Values()) == 0
+		if empty {
+			def, hasDefault := field.Tag.Lookup("default")
+			if !hasDefault {
+				if required, _ := strconv.ParseBool(field.Tag.Get("required")); required {
+					slotErr.Missing = append(slotErr.Missing, name)
+				}
+				continue
+			}
+			value = def
+		}
+
+		var err error
+		if empty {
+			err = setSlotField(rv.Field(n), value, now)
+		} else {
+			err = bindSlotField(rv.Field(n), slot, option, value, now)
+		}
+		if errors.Is(err, ErrNoResolutionMatch) {
+			value = slot.Value
+		}
+		if err != nil {
+			slotErr.Invalid = append(slotErr.Invalid, InvalidSlot{Slot: name, Value: value, Err: err})
+		}
+	}
+
+	if len(slotErr.Missing) > 0 || len(slotErr.Invalid) > 0 {
+		return slotErr
+	}
+	return nil
+}
+
+// BindRequest fills the struct v points to from the slots of the request intent, like
+// BindSlots, resolving relative values against the request timestamp in the time zone
+// set with slottype.NewContext
+func BindRequest(input HandlerInput, v interface{}) error {
+	request := input.GetRequest()
+	if request.Type != "IntentRequest" {
+		return fmt.Errorf("cannot bind slots of a %s", request.Type)
+	}
+	now, err := slottype.RequestTime(request, slottype.LocationFromContext(input.GetContext()))
+	if err != nil {
+		return err
+	}
+	return BindSlots(request.Intent, now, v)
+}
+
+// bindSlotField handles the field types that need the whole slot, then falls back to
+// setSlotField with its value
+func bindSlotField(field reflect.Value, slot alexa.IntentSlot, option, value string, now time.Time) error {
+	switch {
+	case field.Type() == intentSlotType:
+		field.Set(reflect.ValueOf(slot))
+		return nil
+
+	case field.Kind() == reflect.Slice && field.Type().Elem().Kind() == reflect.String:
+		values := reflect.MakeSlice(field.Type(), 0, len(slot.Values()))
+		for _, v := range slot.Values() {
+			text := v.Value
+			switch option {
+			case "canonical":
+				text = v.CanonicalValue()
+			case "id":
+				if text = v.ResolvedID(); text == "" {
+					return ErrNoResolutionMatch
+				}
+			}
+			values = reflect.Append(values, reflect.ValueOf(text).Convert(field.Type().Elem()))
+		}
+		field.Set(values)
+		return nil
+
+	case option == "id" && value == "":
+		return ErrNoResolutionMatch
+	}
+
+	return setSlotField(field, value, now)
+}
+
+// setSlotField parses a slot value into a field
+func setSlotField(field reflect.Value, value string, now time.Time) error {
+	switch field.Type() {
+	case durationType:
+		d, err := slottype.ParseDuration(value, now)
+		if err == nil {
+			field.SetInt(int64(d))
+		}
+		return err
+	case dateRangeType:
+		r, err := slottype.ParseDate(value, now)
+		if err == nil {
+			field.Set(reflect.ValueOf(r))
+		}
+		return err
+	case timeRangeType:
+		r, err := slottype.ParseTime(value, now)
+		if err == nil {
+			field.Set(reflect.ValueOf(r))
+		}
+		return err
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := slottype.ParseNumber(value)
+		if err != nil {
+			return err
+		}
+		if field.OverflowInt(int64(n)) {
+			return errors.New("number out of range")
+		}
+		field.SetInt(int64(n))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := slottype.ParseNumber(value)
+		if err != nil {
+			return err
+		}
+		if n < 0 || field.OverflowUint(uint64(n)) {
+			return errors.New("number out of range")
+		}
+		field.SetUint(uint64(n))
+	case reflect.Float32, reflect.Float64:
+		if value == "?" {
+			return slottype.ErrUnknown
+		}
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("unrecognized number %q", value)
+		}
+		field.SetFloat(f)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("unrecognized bool %q", value)
+		}
+		field.SetBool(b)
+	default:
+		return fmt.Errorf("cannot bind a slot to a %s field", field.Type())
+	}
+	return nil
+}
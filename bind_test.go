@@ -0,0 +1,100 @@
+package askgo_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/spirilis/askgo"
+	"github.com/spirilis/askgo/alexa"
+	"github.com/spirilis/askgo/slottype"
+	"github.com/stretchr/testify/require"
+)
+
+type budgetSlots struct {
+	Amount   float64            `slot:"Amount" default:"0"`
+	Count    int                `slot:"TransactionCount" required:"true"`
+	Category string             `slot:"Category,id"`
+	Items    []string           `slot:"Item"`
+	When     slottype.DateRange `slot:"When"`
+	Every    time.Duration      `slot:"Every"`
+	Ignored  string
+}
+
+func Test_BindSlots(t *testing.T) {
+	intent := alexa.Intent{Name: "BudgetIntent", Slots: map[string]alexa.IntentSlot{
+		"TransactionCount": {Name: "TransactionCount", Value: "12"},
+		"Category": {Name: "Category", Value: "grub", Resolutions: &alexa.Resolutions{
+			ResolutionsPerAuthority: []alexa.Resolution{{
+				Status: alexa.ResolutionStatus{Code: alexa.ResolutionMatch},
+				Values: []alexa.ResolutionItem{{Value: alexa.ResolvedValue{Name: "food", ID: "FOOD"}}},
+			}},
+		}},
+		"When":  {Name: "When", Value: "2026-10"},
+		"Every": {Name: "Every", Value: "P1W"},
+		"Item":  alexa.NewIntent("").SetSlotValues("Item", "milk", "eggs").Slots["Item"],
+	}}
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	var slots budgetSlots
+	require.NoError(t, askgo.BindSlots(intent, now, &slots))
+	require.Equal(t, 0.0, slots.Amount)
+	require.Equal(t, 12, slots.Count)
+	require.Equal(t, "FOOD", slots.Category)
+	require.Equal(t, []string{"milk", "eggs"}, slots.Items)
+	require.Equal(t, slottype.Month, slots.When.Granularity)
+	require.Equal(t, 7*24*time.Hour, slots.Every)
+
+	intent.Slots["TransactionCount"] = alexa.IntentSlot{Name: "TransactionCount"}
+	intent.Slots["Amount"] = alexa.IntentSlot{Name: "Amount", Value: "?"}
+	intent.Slots["When"] = alexa.IntentSlot{Name: "When", Value: "sometime"}
+
+	err := askgo.BindSlots(intent, now, &slots)
+	var slotErr *askgo.SlotError
+	require.True(t, errors.As(err, &slotErr))
+	require.Equal(t, []string{"TransactionCount"}, slotErr.Missing)
+	require.Len(t, slotErr.Invalid, 2)
+	require.Equal(t, slottype.ErrUnknown, slotErr.Invalid[0].Err)
+	require.Equal(t, "TransactionCount", slotErr.SlotToElicit())
+
+	require.Error(t, askgo.BindSlots(intent, now, slots))
+
+	// a spoken value that matched nothing cannot be bound to its ID
+	intent.Slots["TransactionCount"] = alexa.IntentSlot{Name: "TransactionCount", Value: "3"}
+	intent.Slots["Amount"] = alexa.IntentSlot{Name: "Amount"}
+	intent.Slots["When"] = alexa.IntentSlot{Name: "When"}
+	intent.Slots["Category"] = alexa.IntentSlot{Name: "Category", Value: "gizmos", Resolutions: &alexa.Resolutions{
+		ResolutionsPerAuthority: []alexa.Resolution{{Status: alexa.ResolutionStatus{Code: alexa.ResolutionNoMatch}}},
+	}}
+	err = askgo.BindSlots(intent, now, &slots)
+	require.True(t, errors.As(err, &slotErr))
+	require.Empty(t, slotErr.Missing)
+	require.Equal(t, []askgo.InvalidSlot{{Slot: "Category", Value: "gizmos", Err: askgo.ErrNoResolutionMatch}}, slotErr.Invalid)
+	require.EqualError(t, err, `cannot bind slots: slot Category value "gizmos": no resolution match`)
+}
+
+func Test_InputBindSlots(t *testing.T) {
+	chicago, err := time.LoadLocation("America/Chicago")
+	require.NoError(t, err)
+
+	input := askgo.NewDefaultHandler(slottype.NewContext(context.Background(), chicago), &askgo.RequestEnvelope{
+		Request: alexa.Request{
+			Type:      "IntentRequest",
+			Timestamp: "2026-10-17T03:00:00Z",
+			Intent: alexa.Intent{Name: "WhenIntent", Slots: map[string]alexa.IntentSlot{
+				"When": {Name: "When", Value: "PRESENT_REF"},
+			}},
+		},
+	})
+
+	var slots struct {
+		When slottype.DateRange `slot:"When"`
+	}
+	require.NoError(t, input.BindSlots(&slots))
+	require.Equal(t, 16, slots.When.Start.Day())
+
+	launch := askgo.NewDefaultHandler(context.Background(), &askgo.RequestEnvelope{Request: alexa.Request{Type: "LaunchRequest"}})
+	require.Error(t, launch.BindSlots(&slots))
+	require.Error(t, askgo.BindRequest(launch, &slots))
+}
@@ -0,0 +1,279 @@
+// Package builtin provides configurable handlers for the requests every skill has to
+// answer: AMAZON.HelpIntent, Stop/Cancel/Pause, AMAZON.FallbackIntent,
+// AMAZON.NavigateHomeIntent, SessionEndedRequest, System.ExceptionEncountered and a
+// catch-all error handler.
+//
+// The handlers speak messages from an i18n catalog, so every locale can have its own
+// wording.  Messages missing from the catalog fall back to English defaults.
+//
+//	messages := i18n.NewCatalog().
+//		Set("en", builtin.HelpMessage, "Ask me about a state.").
+//		Set("de", builtin.HelpMessage, "Frag mich nach einem Bundesstaat.")
+//
+//	pack := builtin.New(messages)
+//	pack.Help = &myHelpHandler{}
+//
+//	skill.Handlers = append(skill.Handlers, pack.Handlers()...)
+//	skill.ErrorHandlers = append(skill.ErrorHandlers, pack.ErrorHandlers()...)
+package builtin
+
+import (
+	"log"
+
+	"github.com/spirilis/askgo"
+	"github.com/spirilis/askgo/alexa"
+	"github.com/spirilis/askgo/i18n"
+)
+
+// Catalog keys of the built-in messages
+const (
+	HelpMessage         = "builtin.help"
+	HelpReprompt        = "builtin.help.reprompt"
+	GoodbyeMessage      = "builtin.goodbye"
+	FallbackMessage     = "builtin.fallback"
+	FallbackReprompt    = "builtin.fallback.reprompt"
+	NavigateHomeMessage = "builtin.navigateHome"
+	ErrorMessage        = "builtin.error"
+	ErrorReprompt       = "builtin.error.reprompt"
+)
+
+// DefaultMessages returns a catalog holding the English defaults of the built-in messages
+// as its default locale
+func DefaultMessages() *i18n.Catalog {
+	return i18n.NewCatalog().
+		Set(i18n.DefaultLocale, HelpMessage, "You can say help to hear this again, or stop to exit. What would you like to do?").
+		Set(i18n.DefaultLocale, HelpReprompt, "What would you like to do?").
+		Set(i18n.DefaultLocale, GoodbyeMessage, "Goodbye!").
+		Set(i18n.DefaultLocale, FallbackMessage, "Sorry, I can't help with that. You can say help to hear what I can do.").
+		Set(i18n.DefaultLocale, FallbackReprompt, "What would you like to do?").
+		Set(i18n.DefaultLocale, NavigateHomeMessage, "Goodbye!").
+		Set(i18n.DefaultLocale, ErrorMessage, "Sorry, something went wrong. Please try again.").
+		Set(i18n.DefaultLocale, ErrorReprompt, "What would you like to do?")
+}
+
+var defaults = DefaultMessages()
+
+// Messages renders the built-in messages in the locale of a request
+type Messages struct {
+	// Catalog holds the messages, keys it lacks use the English defaults
+	Catalog *i18n.Catalog
+}
+
+// T renders the message for key in the locale of the request
+func (m *Messages) T(input askgo.HandlerInput, key string) string {
+	locale := input.GetRequest().Locale
+	if m != nil {
+		if _, ok := m.Catalog.Lookup(locale, key); ok {
+			return m.Catalog.Localizer(locale).T(key)
+		}
+	}
+	return defaults.Localizer(locale).T(key)
+}
+
+func isIntent(input askgo.HandlerInput, names ...string) bool {
+	request := input.GetRequest()
+	if request.Type != "IntentRequest" {
+		return false
+	}
+	for _, name := range names {
+		if request.Intent.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Pack is the set of built-in handlers.  Replace a field to override a handler, or set it
+// to nil to leave the request to the skill's own handlers.
+type Pack struct {
+	Messages *Messages
+
+	Help            askgo.RequestHandler
+	Exit            askgo.RequestHandler
+	Fallback        askgo.RequestHandler
+	NavigateHome    askgo.RequestHandler
+	SessionEnded    askgo.RequestHandler
+	SystemException askgo.RequestHandler
+
+	Error askgo.ErrorHandler
+}
+
+// New returns the built-in handlers speaking messages from the catalog, pass nil to use
+// the English defaults
+func New(catalog *i18n.Catalog) *Pack {
+	messages := &Messages{Catalog: catalog}
+	return &Pack{
+		Messages:        messages,
+		Help:            &HelpHandler{Messages: messages},
+		Exit:            &ExitHandler{Messages: messages},
+		Fallback:        &FallbackHandler{Messages: messages},
+		NavigateHome:    &NavigateHomeHandler{Messages: messages},
+		SessionEnded:    &SessionEndedHandler{},
+		SystemException: &SystemExceptionHandler{},
+		Error:           &ErrorHandler{Messages: messages},
+	}
+}
+
+// Handlers returns the request handlers of the pack that are set
+func (p *Pack) Handlers() []askgo.RequestHandler {
+	handlers := []askgo.RequestHandler{}
+	for _, h := range []askgo.RequestHandler{p.SessionEnded, p.SystemException, p.Help, p.Exit, p.Fallback, p.NavigateHome} {
+		if h != nil {
+			handlers = append(handlers, h)
+		}
+	}
+	return handlers
+}
+
+// ErrorHandlers returns the error handler of the pack if it is set
+func (p *Pack) ErrorHandlers() []askgo.ErrorHandler {
+	if p.Error == nil {
+		return []askgo.ErrorHandler{}
+	}
+	return []askgo.ErrorHandler{p.Error}
+}
+
+//  -----------------------
+
+// HelpHandler answers AMAZON.HelpIntent and keeps the session open
+type HelpHandler struct {
+	Messages *Messages
+}
+
+// CanHandle accepts AMAZON.HelpIntent
+func (h *HelpHandler) CanHandle(input askgo.HandlerInput) bool {
+	return isIntent(input, alexa.HelpIntent)
+}
+
+// Handle speaks the help message
+func (h *HelpHandler) Handle(input askgo.HandlerInput) (*askgo.ResponseEnvelope, error) {
+	return input.GetResponse().
+		WithShouldEndSession(false).
+		SpeakText(h.Messages.T(input, HelpMessage)).
+		RepromptText(h.Messages.T(input, HelpReprompt)), nil
+}
+
+//  -----------------------
+
+// ExitHandler answers AMAZON.StopIntent, AMAZON.CancelIntent and AMAZON.PauseIntent by
+// saying goodbye and ending the session
+type ExitHandler struct {
+	Messages *Messages
+}
+
+// CanHandle accepts the stop, cancel and pause intents
+func (h *ExitHandler) CanHandle(input askgo.HandlerInput) bool {
+	return isIntent(input, alexa.StopIntent, alexa.CancelIntent, alexa.PauseIntent)
+}
+
+// Handle speaks the goodbye message
+func (h *ExitHandler) Handle(input askgo.HandlerInput) (*askgo.ResponseEnvelope, error) {
+	return input.GetResponse().
+		WithShouldEndSession(true).
+		SpeakText(h.Messages.T(input, GoodbyeMessage)), nil
+}
+
+//  -----------------------
+
+// FallbackHandler answers AMAZON.FallbackIntent and keeps the session open
+type FallbackHandler struct {
+	Messages *Messages
+}
+
+// CanHandle accepts AMAZON.FallbackIntent
+func (h *FallbackHandler) CanHandle(input askgo.HandlerInput) bool {
+	return isIntent(input, alexa.FallbackIntent)
+}
+
+// Handle speaks the fallback message
+func (h *FallbackHandler) Handle(input askgo.HandlerInput) (*askgo.ResponseEnvelope, error) {
+	return input.GetResponse().
+		WithShouldEndSession(false).
+		SpeakText(h.Messages.T(input, FallbackMessage)).
+		RepromptText(h.Messages.T(input, FallbackReprompt)), nil
+}
+
+//  -----------------------
+
+// NavigateHomeHandler answers AMAZON.NavigateHomeIntent by ending the session
+type NavigateHomeHandler struct {
+	Messages *Messages
+}
+
+// CanHandle accepts AMAZON.NavigateHomeIntent
+func (h *NavigateHomeHandler) CanHandle(input askgo.HandlerInput) bool {
+	return isIntent(input, alexa.NavigateHomeIntent)
+}
+
+// Handle speaks the navigate home message
+func (h *NavigateHomeHandler) Handle(input askgo.HandlerInput) (*askgo.ResponseEnvelope, error) {
+	return input.GetResponse().
+		WithShouldEndSession(true).
+		SpeakText(h.Messages.T(input, NavigateHomeMessage)), nil
+}
+
+//  -----------------------
+
+// SessionEndedHandler logs why a session ended.  Alexa does not accept speech in answer
+// to a SessionEndedRequest.
+type SessionEndedHandler struct{}
+
+// CanHandle accepts SessionEndedRequest
+func (h *SessionEndedHandler) CanHandle(input askgo.HandlerInput) bool {
+	return input.GetRequest().Type == "SessionEndedRequest"
+}
+
+// Handle logs the reason and error of the request
+func (h *SessionEndedHandler) Handle(input askgo.HandlerInput) (*askgo.ResponseEnvelope, error) {
+	request := input.GetRequest()
+	if request.Error.Type != "" {
+		log.Printf("SessionEndedRequest requestId=%s, reason=%s, error=%s: %s",
+			request.RequestID, request.Reason, request.Error.Type, request.Error.Message)
+	} else {
+		log.Printf("SessionEndedRequest requestId=%s, reason=%s", request.RequestID, request.Reason)
+	}
+
+	return input.GetResponse().WithShouldEndSession(true), nil
+}
+
+//  -----------------------
+
+// SystemExceptionHandler logs the System.ExceptionEncountered requests Alexa sends when
+// a response could not be processed
+type SystemExceptionHandler struct{}
+
+// CanHandle accepts System.ExceptionEncountered
+func (h *SystemExceptionHandler) CanHandle(input askgo.HandlerInput) bool {
+	return input.GetRequest().Type == "System.ExceptionEncountered"
+}
+
+// Handle logs the error and the request that caused it
+func (h *SystemExceptionHandler) Handle(input askgo.HandlerInput) (*askgo.ResponseEnvelope, error) {
+	request := input.GetRequest()
+	log.Printf("System.ExceptionEncountered requestId=%s, cause=%s, error=%s: %s",
+		request.RequestID, request.Cause.RequestID, request.Error.Type, request.Error.Message)
+
+	return input.GetResponse(), nil
+}
+
+//  -----------------------
+
+// ErrorHandler handles every error by logging it and apologizing to the user
+type ErrorHandler struct {
+	Messages *Messages
+}
+
+// CanHandle accepts any error
+func (h *ErrorHandler) CanHandle(input askgo.HandlerInput, err error) bool {
+	return true
+}
+
+// Handle logs the error and speaks the error message
+func (h *ErrorHandler) Handle(input askgo.HandlerInput, err error) (*askgo.ResponseEnvelope, error) {
+	log.Printf("ErrorHandler requestId=%s: %v", input.GetRequest().RequestID, err)
+
+	return input.GetResponse().
+		WithShouldEndSession(false).
+		SpeakText(h.Messages.T(input, ErrorMessage)).
+		RepromptText(h.Messages.T(input, ErrorReprompt)), nil
+}
@@ -0,0 +1,100 @@
+package builtin_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/spirilis/askgo"
+	"github.com/spirilis/askgo/alexa"
+	"github.com/spirilis/askgo/builtin"
+	"github.com/spirilis/askgo/i18n"
+	"github.com/stretchr/testify/require"
+)
+
+type launchFails struct{}
+
+func (h *launchFails) CanHandle(input askgo.HandlerInput) bool {
+	return input.GetRequest().Type == "LaunchRequest"
+}
+
+func (h *launchFails) Handle(input askgo.HandlerInput) (*askgo.ResponseEnvelope, error) {
+	return nil, errors.New("boom")
+}
+
+type customHelp struct{}
+
+func (h *customHelp) CanHandle(input askgo.HandlerInput) bool {
+	return input.GetRequest().Intent.Name == alexa.HelpIntent
+}
+
+func (h *customHelp) Handle(input askgo.HandlerInput) (*askgo.ResponseEnvelope, error) {
+	return input.GetResponse().SpeakText("custom help"), nil
+}
+
+func process(t *testing.T, skill *askgo.Skill, request alexa.Request) *askgo.ResponseEnvelope {
+	out, err := skill.ProcessRequest(askgo.NewDefaultHandler(context.Background(), &askgo.RequestEnvelope{Request: request}))
+	require.NoError(t, err)
+	return out.(*askgo.ResponseEnvelope)
+}
+
+func intent(locale, name string) alexa.Request {
+	return alexa.Request{Type: "IntentRequest", Locale: locale, Intent: alexa.Intent{Name: name}}
+}
+
+func Test_Pack(t *testing.T) {
+	pack := builtin.New(i18n.NewCatalog().
+		Set("de", builtin.HelpMessage, "Hilfe").
+		Set("de", builtin.GoodbyeMessage, "Tschüss"))
+
+	skill := &askgo.Skill{
+		IgnoreTimestamp: true,
+		Handlers:        append([]askgo.RequestHandler{&launchFails{}}, pack.Handlers()...),
+		ErrorHandlers:   pack.ErrorHandlers(),
+	}
+
+	response := process(t, skill, intent("de-DE", alexa.HelpIntent))
+	require.Equal(t, "Hilfe", response.Response.OutputSpeech.Text)
+	require.False(t, response.Response.ShouldSessionEnd)
+	require.NotNil(t, response.Response.Reprompt)
+
+	response = process(t, skill, intent("en-US", alexa.HelpIntent))
+	require.Contains(t, response.Response.OutputSpeech.Text, "You can say help")
+
+	for _, name := range []string{alexa.StopIntent, alexa.CancelIntent, alexa.PauseIntent} {
+		response = process(t, skill, intent("de-DE", name))
+		require.Equal(t, "Tschüss", response.Response.OutputSpeech.Text)
+		require.True(t, response.Response.ShouldSessionEnd)
+	}
+
+	response = process(t, skill, intent("en-GB", alexa.FallbackIntent))
+	require.Contains(t, response.Response.OutputSpeech.Text, "Sorry, I can't help")
+
+	response = process(t, skill, intent("en-GB", alexa.NavigateHomeIntent))
+	require.True(t, response.Response.ShouldSessionEnd)
+
+	response = process(t, skill, alexa.Request{Type: "SessionEndedRequest", Reason: "ERROR"})
+	require.Nil(t, response.Response.OutputSpeech)
+
+	response = process(t, skill, alexa.Request{Type: "System.ExceptionEncountered"})
+	require.Nil(t, response.Response)
+
+	response = process(t, skill, alexa.Request{Type: "LaunchRequest", Locale: "en-US"})
+	require.Equal(t, "Sorry, something went wrong. Please try again.", response.Response.OutputSpeech.Text)
+}
+
+func Test_Override(t *testing.T) {
+	pack := builtin.New(nil)
+	pack.Help = &customHelp{}
+	pack.Fallback = nil
+	require.Len(t, pack.Handlers(), 5)
+
+	skill := &askgo.Skill{IgnoreTimestamp: true, Handlers: pack.Handlers()}
+	response := process(t, skill, intent("en-US", alexa.HelpIntent))
+	require.Equal(t, "custom help", response.Response.OutputSpeech.Text)
+
+	out, err := skill.ProcessRequest(askgo.NewDefaultHandler(context.Background(),
+		&askgo.RequestEnvelope{Request: intent("en-US", alexa.FallbackIntent)}))
+	require.NoError(t, err)
+	require.Nil(t, out.(*askgo.ResponseEnvelope))
+}
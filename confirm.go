@@ -0,0 +1,204 @@
+package askgo
+
+import (
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/spirilis/askgo/alexa"
+)
+
+// ConfirmSessionKey is the session attribute holding the action waiting for a yes or no
+const ConfirmSessionKey = "askgo.confirm"
+
+// Default texts of confirmations: DefaultConfirmReprompt is the reprompt of AskConfirm,
+// and DefaultConfirmAnswer is said when the action has no callback for the answer
+const (
+	DefaultConfirmReprompt = "Please say yes or no."
+	DefaultConfirmAnswer   = "Okay."
+)
+
+// PendingAction is an action waiting for the user to answer yes or no
+type PendingAction struct {
+	Action  string          `json:"action"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+	// Asked is the timestamp of the request that asked the question
+	Asked string `json:"asked,omitempty"`
+}
+
+// Decode unmarshals the payload given to AskConfirm into v
+func (p *PendingAction) Decode(v interface{}) error {
+	if len(p.Payload) == 0 {
+		return nil
+	}
+	return json.Unmarshal(p.Payload, v)
+}
+
+// ConfirmFunc answers a yes or no to a pending action
+type ConfirmFunc func(input HandlerInput, pending *PendingAction) (*ResponseEnvelope, error)
+
+// AskConfirm asks a yes or no question and stores the action, with a payload that is
+// kept as JSON, until the answer arrives.  The reprompt is DefaultConfirmReprompt.
+// Register the callbacks of the action with Confirmations.On.
+func AskConfirm(input HandlerInput, prompt, action string, payload interface{}) (*ResponseEnvelope, error) {
+	pending := PendingAction{Action: action, Asked: input.GetRequest().Timestamp}
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return nil, err
+		}
+		pending.Payload = data
+	}
+	data, err := json.Marshal(pending)
+	if err != nil {
+		return nil, err
+	}
+	SessionAttributes(input)[ConfirmSessionKey] = string(data)
+
+	return input.GetResponse().WithShouldEndSession(false).Speak(prompt).Reprompt(DefaultConfirmReprompt), nil
+}
+
+// pendingAction returns the action stored in the session attributes of the request
+func pendingAction(input HandlerInput) *PendingAction {
+	stored, ok := input.GetRequestEnvelope().Session.Attributes[ConfirmSessionKey].(string)
+	if !ok {
+		return nil
+	}
+	pending := &PendingAction{}
+	if err := json.Unmarshal([]byte(stored), pending); err != nil {
+		log.Printf("Confirmations: discarding unreadable pending action: %v", err)
+		return nil
+	}
+	return pending
+}
+
+type confirmCallbacks struct {
+	confirm ConfirmFunc
+	deny    ConfirmFunc
+}
+
+// Confirmations routes AMAZON.YesIntent and AMAZON.NoIntent to the callbacks of the action
+// pending from AskConfirm.  Register it as a request handler, and as a request interceptor
+// so the pending action is cleared when the user says anything else:
+//
+//	confirmations := askgo.NewConfirmations().On("new-quiz", startQuiz, goodbye)
+//	skill.RequestInterceptors = append(skill.RequestInterceptors, confirmations)
+//	skill.Handlers = append([]askgo.RequestHandler{confirmations}, skill.Handlers...)
+type Confirmations struct {
+	// Timeout is how long an answer is accepted after the question, 0 waits as long as
+	// the session lasts
+	Timeout time.Duration
+	// Keep lists intents that leave the pending action in place, AMAZON.RepeatIntent
+	// always does
+	Keep []string
+
+	actions map[string]confirmCallbacks
+}
+
+var _ RequestHandler = &Confirmations{}
+var _ RequestInterceptor = &Confirmations{}
+
+// NewConfirmations returns a router without actions
+func NewConfirmations() *Confirmations {
+	return &Confirmations{actions: map[string]confirmCallbacks{}}
+}
+
+// On registers the callbacks run when the action is confirmed or denied.  Either may be
+// nil, the answer is then acknowledged with DefaultConfirmAnswer.
+func (c *Confirmations) On(action string, confirm, deny ConfirmFunc) *Confirmations {
+	if c.actions == nil {
+		c.actions = map[string]confirmCallbacks{}
+	}
+	c.actions[action] = confirmCallbacks{confirm: confirm, deny: deny}
+	return c
+}
+
+// expired reports whether the answer arrived after the timeout
+func (c *Confirmations) expired(input HandlerInput, pending *PendingAction) bool {
+	if c.Timeout == 0 {
+		return false
+	}
+	asked, err := time.Parse(time.RFC3339, pending.Asked)
+	if err != nil {
+		return false
+	}
+	now, err := time.Parse(time.RFC3339, input.GetRequest().Timestamp)
+	if err != nil {
+		return false
+	}
+	return now.Sub(asked) > c.Timeout
+}
+
+func (c *Confirmations) answer(input HandlerInput) (*PendingAction, bool) {
+	request := input.GetRequest()
+	if request.Type != "IntentRequest" {
+		return nil, false
+	}
+	if request.Intent.Name != alexa.YesIntent && request.Intent.Name != alexa.NoIntent {
+		return nil, false
+	}
+	pending := pendingAction(input)
+	if pending == nil || c.expired(input, pending) {
+		return nil, false
+	}
+	return pending, true
+}
+
+// Process clears the pending action when it is not registered with On, or when the
+// request is not a yes or no answering it in time
+func (c *Confirmations) Process(input HandlerInput) error {
+	pending := pendingAction(input)
+	if pending == nil {
+		return nil
+	}
+	// Carry the session attributes over, so a pending action that is kept survives
+	// handlers that do not touch them
+	attributes := SessionAttributes(input)
+	if _, registered := c.actions[pending.Action]; !registered {
+		delete(attributes, ConfirmSessionKey)
+		return nil
+	}
+
+	if _, ok := c.answer(input); ok {
+		return nil
+	}
+	request := input.GetRequest()
+	if request.Type == "IntentRequest" {
+		if request.Intent.Name == alexa.RepeatIntent {
+			return nil
+		}
+		for _, name := range c.Keep {
+			if request.Intent.Name == name {
+				return nil
+			}
+		}
+	}
+	delete(attributes, ConfirmSessionKey)
+	return nil
+}
+
+// CanHandle accepts a yes or no while an action registered with On is pending
+func (c *Confirmations) CanHandle(input HandlerInput) bool {
+	pending, ok := c.answer(input)
+	if !ok {
+		return false
+	}
+	_, registered := c.actions[pending.Action]
+	return registered
+}
+
+// Handle clears the pending action and runs its confirm or deny callback
+func (c *Confirmations) Handle(input HandlerInput) (*ResponseEnvelope, error) {
+	pending, _ := c.answer(input)
+	delete(SessionAttributes(input), ConfirmSessionKey)
+
+	callbacks := c.actions[pending.Action]
+	callback := callbacks.deny
+	if input.GetRequest().Intent.Name == alexa.YesIntent {
+		callback = callbacks.confirm
+	}
+	if callback == nil {
+		return input.GetResponse().Speak(DefaultConfirmAnswer), nil
+	}
+	return callback(input, pending)
+}
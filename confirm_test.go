@@ -0,0 +1,102 @@
+package askgo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spirilis/askgo"
+	"github.com/spirilis/askgo/alexa"
+	"github.com/spirilis/askgo/internal/skilltest"
+	"github.com/stretchr/testify/require"
+)
+
+type askHandler struct {
+	intent string
+	action string
+}
+
+func (h *askHandler) CanHandle(input askgo.HandlerInput) bool {
+	return input.GetRequest().Intent.Name == h.intent
+}
+
+func (h *askHandler) Handle(input askgo.HandlerInput) (*askgo.ResponseEnvelope, error) {
+	return input.(askgo.InputHelpers).AskConfirm("Do you want to start a new quiz?", h.action, map[string]int{"round": 2})
+}
+
+func Test_Confirmations(t *testing.T) {
+	var answers []string
+	confirmations := askgo.NewConfirmations().On("new-quiz",
+		func(input askgo.HandlerInput, pending *askgo.PendingAction) (*askgo.ResponseEnvelope, error) {
+			var payload struct{ Round int }
+			require.NoError(t, pending.Decode(&payload))
+			answers = append(answers, "yes", pending.Action)
+			require.Equal(t, 2, payload.Round)
+			return input.GetResponse().Speak("Here we go"), nil
+		},
+		func(input askgo.HandlerInput, pending *askgo.PendingAction) (*askgo.ResponseEnvelope, error) {
+			answers = append(answers, "no")
+			return input.GetResponse().Speak("Maybe later"), nil
+		})
+	confirmations.On("ping", nil, nil)
+	confirmations.Timeout = time.Minute
+
+	skill := &askgo.Skill{
+		IgnoreTimestamp:     true,
+		RequestInterceptors: []askgo.RequestInterceptor{confirmations},
+		Handlers: []askgo.RequestHandler{
+			confirmations,
+			&askHandler{intent: "QuizIntent", action: "new-quiz"},
+			&askHandler{intent: "PingIntent", action: "ping"},
+			&askHandler{intent: "LegacyIntent", action: "legacy"},
+			&skilltest.IntentHandler{Intent: alexa.RepeatIntent, Speech: "repeat"},
+			&skilltest.IntentHandler{Intent: "AnswerIntent", Speech: "answer"},
+		},
+	}
+
+	session := skilltest.NewSession(t, skill)
+	turn := func(intent, timestamp string) *askgo.ResponseEnvelope {
+		envelope := skilltest.IntentRequest("en-US", intent)
+		envelope.Request.Timestamp = timestamp
+		return session.Process(envelope)
+	}
+
+	// No question asked
+	require.Nil(t, turn(alexa.YesIntent, "2026-10-16T12:00:00Z"))
+
+	response := turn("QuizIntent", "2026-10-16T12:00:00Z")
+	require.Equal(t, "<speak>"+askgo.DefaultConfirmReprompt+"</speak>", response.Response.Reprompt.OutputSpeech.SSML)
+	require.Contains(t, session.Attributes, askgo.ConfirmSessionKey)
+	turn(alexa.RepeatIntent, "2026-10-16T12:00:10Z")
+	require.Contains(t, session.Attributes, askgo.ConfirmSessionKey)
+	response = turn(alexa.YesIntent, "2026-10-16T12:00:20Z")
+	require.Equal(t, "<speak>Here we go</speak>", response.Response.OutputSpeech.SSML)
+	require.NotContains(t, session.Attributes, askgo.ConfirmSessionKey)
+
+	turn("QuizIntent", "2026-10-16T12:01:00Z")
+	turn(alexa.NoIntent, "2026-10-16T12:01:05Z")
+	require.Equal(t, []string{"yes", "new-quiz", "no"}, answers)
+
+	// Another intent clears the question
+	turn("QuizIntent", "2026-10-16T12:02:00Z")
+	turn("AnswerIntent", "2026-10-16T12:02:01Z")
+	require.NotContains(t, session.Attributes, askgo.ConfirmSessionKey)
+	require.Nil(t, turn(alexa.YesIntent, "2026-10-16T12:02:02Z"))
+
+	// Answers after the timeout are not routed
+	turn("QuizIntent", "2026-10-16T12:03:00Z")
+	require.Nil(t, turn(alexa.YesIntent, "2026-10-16T12:05:00Z"))
+	require.Len(t, answers, 3)
+
+	// An action without callbacks is acknowledged
+	turn("PingIntent", "2026-10-16T12:04:00Z")
+	response = turn(alexa.YesIntent, "2026-10-16T12:04:05Z")
+	require.Equal(t, "<speak>"+askgo.DefaultConfirmAnswer+"</speak>", response.Response.OutputSpeech.SSML)
+	require.NotContains(t, session.Attributes, askgo.ConfirmSessionKey)
+
+	// An action that is not registered is cleared, even by intents that keep the others
+	turn("LegacyIntent", "2026-10-16T12:05:00Z")
+	require.Contains(t, session.Attributes, askgo.ConfirmSessionKey)
+	turn(alexa.RepeatIntent, "2026-10-16T12:05:05Z")
+	require.NotContains(t, session.Attributes, askgo.ConfirmSessionKey)
+	require.Len(t, answers, 3)
+}
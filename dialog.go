@@ -0,0 +1,123 @@
+package askgo
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spirilis/askgo/alexa"
+)
+
+// ErrNotIntentRequest is returned by the dialog helpers when the request is not an
+// IntentRequest, as Alexa rejects Dialog directives sent in answer to anything else
+var ErrNotIntentRequest = errors.New("dialog directives can only answer an IntentRequest")
+
+// dialogIntent returns the updatedIntent for a Dialog directive answering the request
+func dialogIntent(input HandlerInput) (*alexa.Intent, error) {
+	request := input.GetRequest()
+	if request.Type != "IntentRequest" {
+		return nil, ErrNotIntentRequest
+	}
+	return request.Intent.Updated(), nil
+}
+
+// dialogSlot checks the intent has the slot and returns it
+func dialogSlot(intent *alexa.Intent, name string) (alexa.IntentSlot, error) {
+	slot, ok := intent.Slots[name]
+	if !ok {
+		return slot, fmt.Errorf("intent %s has no slot %s", intent.Name, name)
+	}
+	return slot, nil
+}
+
+// Delegate answers with a Dialog.Delegate directive so Alexa prompts for the next slot
+// of the dialog model, keeping the slot values and confirmation statuses of the request
+func Delegate(input HandlerInput) (*ResponseEnvelope, error) {
+	intent, err := dialogIntent(input)
+	if err != nil {
+		return nil, err
+	}
+	return input.GetResponse().AddDelegateDirective(intent), nil
+}
+
+// ElicitSlot answers with a Dialog.ElicitSlot directive asking for the slot again.  The
+// slot is cleared while the other slot values and confirmation statuses are kept.  Add
+// the prompt to the response with Speak.
+func ElicitSlot(input HandlerInput, slot string) (*ResponseEnvelope, error) {
+	intent, err := dialogIntent(input)
+	if err != nil {
+		return nil, err
+	}
+	s, err := dialogSlot(intent, slot)
+	if err != nil {
+		return nil, err
+	}
+	intent.Slots[slot] = alexa.IntentSlot{Name: s.Name, ConfirmationStatus: alexa.ConfirmationNone}
+
+	return input.GetResponse().AddElicitSlotDirective(slot, intent), nil
+}
+
+// ConfirmSlot answers with a Dialog.ConfirmSlot directive asking the user to confirm the
+// value of the slot, keeping the other slot values and confirmation statuses.  Add the
+// prompt, repeating the value, to the response with Speak.
+func ConfirmSlot(input HandlerInput, slot string) (*ResponseEnvelope, error) {
+	intent, err := dialogIntent(input)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := dialogSlot(intent, slot); err != nil {
+		return nil, err
+	}
+	return input.GetResponse().AddConfirmSlotDirective(slot, intent), nil
+}
+
+// ConfirmIntent answers with a Dialog.ConfirmIntent directive asking the user to confirm
+// the whole intent.  Add the prompt, repeating the values, to the response with Speak.
+func ConfirmIntent(input HandlerInput) (*ResponseEnvelope, error) {
+	intent, err := dialogIntent(input)
+	if err != nil {
+		return nil, err
+	}
+	return input.GetResponse().AddConfirmIntentDirective(intent), nil
+}
+
+// DialogHandler runs the dialog of an intent with a dialog model: it delegates to Alexa
+// while the dialog is STARTED or IN_PROGRESS and calls Completed once every required
+// slot is filled and confirmed.
+//
+//	skill.Handlers = append(skill.Handlers, &askgo.DialogHandler{
+//		Intent:    "BookTableIntent",
+//		Completed: bookTable,
+//	})
+type DialogHandler struct {
+	// Intent is the name of the intent the dialog is for
+	Intent string
+	// InProgress, when set, answers the STARTED and IN_PROGRESS turns instead of
+	// Delegate, for instance to validate a slot and call ElicitSlot
+	InProgress func(input HandlerInput) (*ResponseEnvelope, error)
+	// Completed answers the COMPLETED turn, or a request sent without a dialog state
+	Completed func(input HandlerInput) (*ResponseEnvelope, error)
+}
+
+var _ RequestHandler = &DialogHandler{}
+
+// CanHandle accepts IntentRequests for the intent of the dialog
+func (h *DialogHandler) CanHandle(input HandlerInput) bool {
+	request := input.GetRequest()
+	return request.Type == "IntentRequest" && request.Intent.Name == h.Intent
+}
+
+// Handle delegates or calls the hook matching the dialog state
+func (h *DialogHandler) Handle(input HandlerInput) (*ResponseEnvelope, error) {
+	switch input.GetRequest().DialogState {
+	case alexa.DialogStarted, alexa.DialogInProgress:
+		if h.InProgress != nil {
+			return h.InProgress(input)
+		}
+		return Delegate(input)
+	}
+
+	if h.Completed == nil {
+		return nil, fmt.Errorf("dialog for %s has no Completed hook", h.Intent)
+	}
+	return h.Completed(input)
+}
@@ -0,0 +1,89 @@
+package askgo_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/spirilis/askgo"
+	"github.com/spirilis/askgo/alexa"
+	"github.com/stretchr/testify/require"
+)
+
+func dialogInput(state alexa.DialogState) askgo.HandlerInput {
+	return askgo.NewDefaultHandler(context.Background(), &askgo.RequestEnvelope{
+		Request: alexa.Request{
+			Type:        "IntentRequest",
+			DialogState: state,
+			Intent: alexa.Intent{
+				Name:               "BookTableIntent",
+				ConfirmationStatus: alexa.ConfirmationNone,
+				Slots: map[string]alexa.IntentSlot{
+					"People": {Name: "People", Value: "4", ConfirmationStatus: alexa.Confirmed},
+					"Time": {Name: "Time", Value: "25:00", Resolutions: &alexa.Resolutions{
+						ResolutionsPerAuthority: []alexa.Resolution{{Status: alexa.ResolutionStatus{Code: alexa.ResolutionNoMatch}}},
+					}},
+				},
+			},
+		},
+	})
+}
+
+func directive(t *testing.T, response *askgo.ResponseEnvelope) map[string]interface{} {
+	require.Len(t, response.Response.Directives, 1)
+	data, err := json.Marshal(response.Response.Directives[0])
+	require.NoError(t, err)
+	out := map[string]interface{}{}
+	require.NoError(t, json.Unmarshal(data, &out))
+	return out
+}
+
+func Test_DialogHandler(t *testing.T) {
+	completed := false
+	handler := &askgo.DialogHandler{
+		Intent: "BookTableIntent",
+		Completed: func(input askgo.HandlerInput) (*askgo.ResponseEnvelope, error) {
+			completed = true
+			return input.GetResponse().Speak("Booked"), nil
+		},
+	}
+
+	input := dialogInput(alexa.DialogInProgress)
+	require.True(t, handler.CanHandle(input))
+	response, err := handler.Handle(input)
+	require.NoError(t, err)
+	d := directive(t, response)
+	require.Equal(t, "Dialog.Delegate", d["type"])
+	slots := d["updatedIntent"].(map[string]interface{})["slots"].(map[string]interface{})
+	require.Equal(t, "CONFIRMED", slots["People"].(map[string]interface{})["confirmationStatus"])
+	require.NotContains(t, slots["Time"], "resolutions")
+	require.False(t, completed)
+
+	_, err = handler.Handle(dialogInput(alexa.DialogCompleted))
+	require.NoError(t, err)
+	require.True(t, completed)
+}
+
+func Test_ElicitSlot(t *testing.T) {
+	response, err := askgo.ElicitSlot(dialogInput(alexa.DialogInProgress), "Time")
+	require.NoError(t, err)
+	d := directive(t, response)
+	require.Equal(t, "Dialog.ElicitSlot", d["type"])
+	require.Equal(t, "Time", d["slotToElicit"])
+	slots := d["updatedIntent"].(map[string]interface{})["slots"].(map[string]interface{})
+	require.NotContains(t, slots["Time"], "value")
+	require.Equal(t, "4", slots["People"].(map[string]interface{})["value"])
+
+	response, err = askgo.ConfirmSlot(dialogInput(alexa.DialogInProgress), "People")
+	require.NoError(t, err)
+	require.Equal(t, "People", directive(t, response)["slotToConfirm"])
+
+	_, err = askgo.ElicitSlot(dialogInput(alexa.DialogInProgress), "Date")
+	require.Error(t, err)
+
+	launch := askgo.NewDefaultHandler(context.Background(), &askgo.RequestEnvelope{Request: alexa.Request{Type: "LaunchRequest"}})
+	_, err = askgo.ConfirmIntent(launch)
+	require.Equal(t, askgo.ErrNotIntentRequest, err)
+	_, err = askgo.Delegate(launch)
+	require.Equal(t, askgo.ErrNotIntentRequest, err)
+}
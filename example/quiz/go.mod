@@ -1,8 +1,19 @@
 module github.com/spirilis/askgo/example/quiz
 
+go 1.23.3
+
 require (
 	github.com/aws/aws-lambda-go v1.6.0
 	github.com/fatih/structs v1.0.0
-	github.com/spirilis/askgo v0.0.0-20180829124838-a4e9f02de994
 	github.com/mitchellh/mapstructure v1.0.0
+	github.com/spirilis/askgo v0.0.0-20180829124838-a4e9f02de994
+	github.com/stretchr/testify v1.2.2
 )
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/spirilis/askgo => ../..
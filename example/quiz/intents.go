@@ -9,13 +9,9 @@ import (
 	"github.com/fatih/structs"
 	"github.com/spirilis/askgo"
 	"github.com/spirilis/askgo/alexa"
+	"github.com/spirilis/askgo/resolve"
 )
 
-func supportsDisplay(ctx alexa.Context) bool {
-	_, found := ctx.System.Device.SupportedInterfaces["Display"]
-	return found
-}
-
 //  -----------------------
 var attributeContext struct{}
 
@@ -170,7 +166,7 @@ func (h *quizHandler) Handle(input askgo.HandlerInput) (*askgo.ResponseEnvelope,
 	askQuestion(request, attributes)
 	question := getQuestion(attributes)
 
-	if supportsDisplay(input.GetRequestEnvelope().Context) {
+	if askgo.Supports(input, alexa.InterfaceDisplay) {
 		title := fmt.Sprintf("Question #%v", attributes.Counter)
 
 		image := &alexa.DisplayImageObject{}
@@ -300,6 +296,21 @@ func (h *definitionHandler) Handle(input askgo.HandlerInput) (*askgo.ResponseEnv
 }
 
 //  -----------------------
+
+// answerThreshold is stricter than the resolver default, so a neighbouring name such as
+// Kansas for Arkansas is not taken for the answer
+const answerThreshold = 0.85
+
+// isCorrectAnswer looks for the answer in every slot of the intent, as Alexa is not good
+// at putting it in the matching slot, and accepts names that are misheard or misspelt.
+// Abbreviations and years have to match exactly.
+func isCorrectAnswer(answer string, intent alexa.Intent) bool {
+	r := resolve.New(resolve.Value{Name: answer})
+	r.Threshold = answerThreshold
+	_, ok := r.ResolveIntent(intent)
+	return ok
+}
+
 type quizAnswerHandler struct{}
 
 func (h *quizAnswerHandler) CanHandle(input askgo.HandlerInput) bool {
@@ -315,15 +326,7 @@ func (h *quizAnswerHandler) Handle(input askgo.HandlerInput) (*askgo.ResponseEnv
 
 	log.Printf("QuizAnswerHandler requestId=%s, sessionId=%s", request.RequestID, attributes.sessionID)
 
-	var isCorrect bool
-
-	// Alexa is not good at putting the answer in the matching slot
-	for _, prop := range request.Intent.Slots {
-		if strings.EqualFold(prop.Value, attributes.QuizAnswer) {
-			isCorrect = true
-			break
-		}
-	}
+	isCorrect := isCorrectAnswer(attributes.QuizAnswer, request.Intent)
 
 	var cons string
 
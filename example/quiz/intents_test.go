@@ -0,0 +1,29 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/spirilis/askgo/alexa"
+	"github.com/stretchr/testify/require"
+)
+
+func answerIntent(slot, value string) alexa.Intent {
+	return *alexa.NewIntent("AnswerIntent").SetSlot(slot, value)
+}
+
+func Test_IsCorrectAnswer(t *testing.T) {
+	require.True(t, isCorrectAnswer("Columbia", answerIntent("City", "columbia")))
+	require.True(t, isCorrectAnswer("Montgomery", answerIntent("State", "montgomery")))
+	require.True(t, isCorrectAnswer("Little Rock", answerIntent("City", "little rok")))
+	require.True(t, isCorrectAnswer("ME", answerIntent("StateAbbr", "me")))
+	require.True(t, isCorrectAnswer("1788", answerIntent("Number", "1788")))
+
+	for answer, wrong := range map[string]string{
+		"Arkansas": "Kansas",
+		"Columbia": "Columbus",
+		"ME":       "MA",
+		"1788":     "1787",
+	} {
+		require.False(t, isCorrectAnswer(answer, answerIntent("Answer", wrong)), "%s accepted for %s", wrong, answer)
+	}
+}
@@ -0,0 +1,296 @@
+// Package form collects the fields of a form over several turns without a dialog model.
+//
+// The partially filled form is kept in the session attributes, so its fields can be
+// given in any order and through different intents.  The form asks for the next missing
+// field, asks again when a value fails validation, and calls Complete with the filled
+// struct once every field has a value.
+//
+//	type order struct {
+//		Size  string `slot:"Size"`
+//		Count int    `slot:"Count"`
+//	}
+//
+//	var orderForm = &form.Form[order]{
+//		Name:    "order",
+//		Intents: []string{"OrderIntent", "SizeIntent", "CountIntent"},
+//		Fields: []*form.Field{
+//			{Name: "Size", Prompt: "What size would you like?"},
+//			{Name: "Count", Prompt: "How many?", Validate: positive},
+//		},
+//		Complete: placeOrder,
+//	}
+//
+// Prompts and messages go through askgo.T, so they may be message catalog keys.
+package form
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/spirilis/askgo"
+	"github.com/spirilis/askgo/alexa"
+	"github.com/spirilis/askgo/slottype"
+)
+
+// SessionKeyPrefix starts the session attribute holding a form, followed by its name
+const SessionKeyPrefix = "askgo.form."
+
+// DefaultMaxAttempts is the number of invalid values accepted for a field before Escape
+// is called, when the form does not set its own
+const DefaultMaxAttempts = 3
+
+// ErrTooManyAttempts is returned when a field is given too many invalid values and the
+// form has no Escape hook
+var ErrTooManyAttempts = errors.New("too many invalid values")
+
+// Field is one value collected by a form
+type Field struct {
+	// Name of the field, the slot tag of the struct field it fills
+	Name string
+	// Slots whose values fill the field, by default the slot named like the field
+	Slots []string
+	// Prompt asks for the field and Reprompt is used when the user says nothing,
+	// Reprompt defaults to Prompt
+	Prompt   string
+	Reprompt string
+	// Validate checks a value, resolved to its canonical name, nil accepts anything.  It is
+	// called for each value of a multiple-value slot.
+	Validate func(input askgo.HandlerInput, value string) error
+	// Invalid is spoken before asking again when Validate fails
+	Invalid string
+}
+
+func (f *Field) slots() []string {
+	if len(f.Slots) == 0 {
+		return []string{f.Name}
+	}
+	return f.Slots
+}
+
+// Form is a set of fields filled into a T, a struct with slot tagged fields as used by
+// askgo.BindSlots
+type Form[T any] struct {
+	// Name keeps the session attributes of several forms apart
+	Name string
+	// Intents start the form or give values for it
+	Intents []string
+	Fields  []*Field
+	// MaxAttempts is the number of invalid values accepted for a field, 0 uses
+	// DefaultMaxAttempts
+	MaxAttempts int
+
+	// Complete is called with the filled struct once every field has a value
+	Complete func(input askgo.HandlerInput, values *T) (*askgo.ResponseEnvelope, error)
+	// Escape is called, after the form is cleared, when a field was given too many
+	// invalid values.  Without it ErrTooManyAttempts is returned for the error handlers.
+	Escape func(input askgo.HandlerInput, field *Field) (*askgo.ResponseEnvelope, error)
+}
+
+var _ askgo.RequestHandler = &Form[struct{}]{}
+
+// state is the partially filled form stored in the session attributes.  The slots are
+// kept whole, with their resolutions and multiple values, for the slot options of the
+// struct tags.
+type state struct {
+	Slots    map[string]alexa.IntentSlot `json:"slots"`
+	Asking   string                      `json:"asking,omitempty"`
+	Attempts int                         `json:"attempts,omitempty"`
+}
+
+func (f *Form[T]) sessionKey() string {
+	return SessionKeyPrefix + f.Name
+}
+
+func (f *Form[T]) load(input askgo.HandlerInput) (*state, bool) {
+	stored, ok := input.GetRequestEnvelope().Session.Attributes[f.sessionKey()].(string)
+	if !ok {
+		return &state{Slots: map[string]alexa.IntentSlot{}}, false
+	}
+	s := &state{}
+	if err := json.Unmarshal([]byte(stored), s); err != nil || s.Slots == nil {
+		log.Printf("form %s: discarding unreadable state: %v", f.Name, err)
+		return &state{Slots: map[string]alexa.IntentSlot{}}, false
+	}
+	return s, true
+}
+
+func (f *Form[T]) save(input askgo.HandlerInput, s *state) error {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	askgo.SessionAttributes(input)[f.sessionKey()] = string(data)
+	return nil
+}
+
+// Active reports whether the form has been started and not yet completed or cancelled
+func (f *Form[T]) Active(input askgo.HandlerInput) bool {
+	_, ok := f.load(input)
+	return ok
+}
+
+// Cancel discards the partially filled form
+func (f *Form[T]) Cancel(input askgo.HandlerInput) {
+	delete(askgo.SessionAttributes(input), f.sessionKey())
+}
+
+// CanHandle accepts the intents of the form, and while the form is active any intent
+// with a slot for one of its fields
+func (f *Form[T]) CanHandle(input askgo.HandlerInput) bool {
+	request := input.GetRequest()
+	if request.Type != "IntentRequest" {
+		return false
+	}
+	for _, name := range f.Intents {
+		if request.Intent.Name == name {
+			return true
+		}
+	}
+	if !f.Active(input) {
+		return false
+	}
+	for _, field := range f.Fields {
+		for _, slot := range field.slots() {
+			if len(request.Intent.Slots[slot].Values()) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Handle stores the valid field values given by the request, then asks again for the
+// first field given an invalid value, or for the next missing field, or completes the form
+func (f *Form[T]) Handle(input askgo.HandlerInput) (*askgo.ResponseEnvelope, error) {
+	s, _ := f.load(input)
+	intent := input.GetRequest().Intent
+
+	var invalidField *Field
+	var invalidValue string
+	var invalidErr error
+	for _, field := range f.Fields {
+		var given alexa.IntentSlot
+		for _, slot := range field.slots() {
+			if len(intent.Slots[slot].Values()) > 0 {
+				given = intent.Slots[slot]
+				break
+			}
+		}
+		values := given.Values()
+		if len(values) == 0 {
+			continue
+		}
+
+		if value, err := validate(input, field, values); err != nil {
+			if invalidField == nil {
+				invalidField, invalidValue, invalidErr = field, value, err
+			}
+			continue
+		}
+
+		given.Name = field.Name
+		s.Slots[field.Name] = given
+		if s.Asking == field.Name {
+			s.Attempts = 0
+		}
+	}
+	if invalidField != nil {
+		return f.invalid(input, s, invalidField, invalidValue, invalidErr)
+	}
+
+	for _, field := range f.Fields {
+		if _, ok := s.Slots[field.Name]; ok {
+			continue
+		}
+		if s.Asking != field.Name {
+			s.Asking, s.Attempts = field.Name, 0
+		}
+		if err := f.save(input, s); err != nil {
+			return nil, err
+		}
+		return f.ask(input, field, ""), nil
+	}
+
+	return f.complete(input, s)
+}
+
+// validate checks the values given for a field, returning the first invalid one with its error
+func validate(input askgo.HandlerInput, field *Field, values []alexa.SlotValue) (string, error) {
+	for _, v := range values {
+		value := v.CanonicalValue()
+		if value == "?" {
+			return value, slottype.ErrUnknown
+		}
+		if field.Validate != nil {
+			if err := field.Validate(input, value); err != nil {
+				return value, err
+			}
+		}
+	}
+	return "", nil
+}
+
+// invalid asks for a field again, or escapes when it was given too many invalid values
+func (f *Form[T]) invalid(input askgo.HandlerInput, s *state, field *Field, value string, err error) (*askgo.ResponseEnvelope, error) {
+	log.Printf("form %s: field %s value %q is invalid: %v", f.Name, field.Name, value, err)
+
+	if s.Asking != field.Name {
+		s.Asking, s.Attempts = field.Name, 0
+	}
+	s.Attempts++
+
+	maxAttempts := f.MaxAttempts
+	if maxAttempts == 0 {
+		maxAttempts = DefaultMaxAttempts
+	}
+	if s.Attempts >= maxAttempts {
+		f.Cancel(input)
+		if f.Escape == nil {
+			return nil, fmt.Errorf("form %s field %s: %w", f.Name, field.Name, ErrTooManyAttempts)
+		}
+		return f.Escape(input, field)
+	}
+
+	if err := f.save(input, s); err != nil {
+		return nil, err
+	}
+	return f.ask(input, field, field.Invalid), nil
+}
+
+// ask prompts for a field, after the message if there is one
+func (f *Form[T]) ask(input askgo.HandlerInput, field *Field, message string) *askgo.ResponseEnvelope {
+	prompt := askgo.T(input, field.Prompt)
+	reprompt := prompt
+	if field.Reprompt != "" {
+		reprompt = askgo.T(input, field.Reprompt)
+	}
+	if message != "" {
+		prompt = askgo.T(input, message) + " " + prompt
+	}
+	return input.GetResponse().WithShouldEndSession(false).Speak(prompt).Reprompt(reprompt)
+}
+
+// complete binds the values into a T, clears the form and calls Complete.  The form is
+// kept when the values cannot be bound.
+func (f *Form[T]) complete(input askgo.HandlerInput, s *state) (*askgo.ResponseEnvelope, error) {
+	filled := alexa.Intent{Name: input.GetRequest().Intent.Name, Slots: s.Slots}
+	now, err := slottype.RequestTime(input.GetRequest(), slottype.LocationFromContext(input.GetContext()))
+	if err != nil {
+		return nil, err
+	}
+
+	values := new(T)
+	if err := askgo.BindSlots(filled, now, values); err != nil {
+		if saveErr := f.save(input, s); saveErr != nil {
+			return nil, saveErr
+		}
+		return nil, fmt.Errorf("form %s: %w", f.Name, err)
+	}
+	f.Cancel(input)
+	if f.Complete == nil {
+		return nil, fmt.Errorf("form %s has no Complete callback", f.Name)
+	}
+	return f.Complete(input, values)
+}
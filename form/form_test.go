@@ -0,0 +1,187 @@
+package form_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/spirilis/askgo"
+	"github.com/spirilis/askgo/alexa"
+	"github.com/spirilis/askgo/form"
+	"github.com/spirilis/askgo/internal/skilltest"
+	"github.com/stretchr/testify/require"
+)
+
+type order struct {
+	Size  string `slot:"Size"`
+	Count int    `slot:"Count"`
+}
+
+func positive(input askgo.HandlerInput, value string) error {
+	if n, err := strconv.Atoi(value); err != nil || n < 1 {
+		return errors.New("not a positive number")
+	}
+	return nil
+}
+
+// session runs turns of a session through the form
+type session[T any] struct {
+	*skilltest.Session
+	form *form.Form[T]
+}
+
+func newSession[T any](t *testing.T, f *form.Form[T]) *session[T] {
+	return &session[T]{Session: skilltest.NewSession(t, nil), form: f}
+}
+
+func (s *session[T]) turn(intent string, slots map[string]string) (*askgo.ResponseEnvelope, error) {
+	request := alexa.NewIntent(intent)
+	for name, value := range slots {
+		request.SetSlot(name, value)
+	}
+	return s.turnIntent(*request)
+}
+
+func (s *session[T]) turnIntent(intent alexa.Intent) (*askgo.ResponseEnvelope, error) {
+	input := s.Input(&askgo.RequestEnvelope{Request: alexa.Request{Type: "IntentRequest", Intent: intent}})
+	if !s.form.CanHandle(input) {
+		return nil, nil
+	}
+	response, err := s.form.Handle(input)
+	s.Keep(input.GetResponse())
+	return response, err
+}
+
+func newForm(completed *order) *form.Form[order] {
+	return &form.Form[order]{
+		Name:    "order",
+		Intents: []string{"OrderIntent"},
+		Fields: []*form.Field{
+			{Name: "Size", Prompt: "What size?"},
+			{Name: "Count", Slots: []string{"Count", "Number"}, Prompt: "How many?", Reprompt: "How many would you like?",
+				Validate: positive, Invalid: "That's not a number I can use."},
+		},
+		Complete: func(input askgo.HandlerInput, values *order) (*askgo.ResponseEnvelope, error) {
+			*completed = *values
+			return input.GetResponse().Speak("Ordered"), nil
+		},
+	}
+}
+
+func Test_Form(t *testing.T) {
+	var completed order
+	s := newSession(t, newForm(&completed))
+
+	response, err := s.turn("AnswerIntent", map[string]string{"Size": "large"})
+	require.NoError(t, err)
+	require.Nil(t, response, "form is not active yet")
+
+	response, err = s.turn("OrderIntent", map[string]string{"Size": "large"})
+	require.NoError(t, err)
+	require.Equal(t, "<speak>How many?</speak>", response.Response.OutputSpeech.SSML)
+	require.Equal(t, "<speak>How many would you like?</speak>", response.Response.Reprompt.OutputSpeech.SSML)
+
+	response, err = s.turn("AnswerIntent", map[string]string{"Number": "0"})
+	require.NoError(t, err)
+	require.Equal(t, "<speak>That's not a number I can use. How many?</speak>", response.Response.OutputSpeech.SSML)
+
+	response, err = s.turn("AnswerIntent", map[string]string{"Number": "2"})
+	require.NoError(t, err)
+	require.Equal(t, "<speak>Ordered</speak>", response.Response.OutputSpeech.SSML)
+	require.Equal(t, order{Size: "large", Count: 2}, completed)
+	require.Empty(t, s.Attributes)
+}
+
+func Test_FormKeepsValidFields(t *testing.T) {
+	var completed order
+	f := newForm(&completed)
+	f.Fields[0], f.Fields[1] = f.Fields[1], f.Fields[0]
+	s := newSession(t, f)
+
+	// the size is kept while the count is asked for again
+	response, err := s.turn("OrderIntent", map[string]string{"Count": "0", "Size": "large"})
+	require.NoError(t, err)
+	require.Equal(t, "<speak>That's not a number I can use. How many?</speak>", response.Response.OutputSpeech.SSML)
+
+	response, err = s.turn("AnswerIntent", map[string]string{"Number": "2"})
+	require.NoError(t, err)
+	require.Equal(t, "<speak>Ordered</speak>", response.Response.OutputSpeech.SSML)
+	require.Equal(t, order{Size: "large", Count: 2}, completed)
+}
+
+func Test_FormEscape(t *testing.T) {
+	var completed order
+	s := newSession(t, newForm(&completed))
+
+	_, err := s.turn("OrderIntent", nil)
+	require.NoError(t, err)
+	for n := 0; n < form.DefaultMaxAttempts-1; n++ {
+		_, err = s.turn("OrderIntent", map[string]string{"Count": "?"})
+		require.NoError(t, err)
+	}
+	_, err = s.turn("OrderIntent", map[string]string{"Count": "-1"})
+	require.True(t, errors.Is(err, form.ErrTooManyAttempts))
+	require.Empty(t, s.Attributes)
+
+	escaped := ""
+	s.form.Escape = func(input askgo.HandlerInput, field *form.Field) (*askgo.ResponseEnvelope, error) {
+		escaped = field.Name
+		return input.GetResponse().Speak("Let's start over"), nil
+	}
+	s.form.MaxAttempts = 1
+	_, err = s.turn("OrderIntent", map[string]string{"Size": "small", "Count": "none"})
+	require.NoError(t, err)
+	require.Equal(t, "Count", escaped)
+}
+
+type pizza struct {
+	Size     string   `slot:"Size,id"`
+	Crust    string   `slot:"Crust,canonical"`
+	Toppings []string `slot:"Toppings,canonical"`
+	Count    int      `slot:"Count"`
+}
+
+func resolved(name, value, id string) alexa.IntentSlot {
+	return alexa.IntentSlot{Name: name, Value: value, Resolutions: &alexa.Resolutions{
+		ResolutionsPerAuthority: []alexa.Resolution{{
+			Status: alexa.ResolutionStatus{Code: alexa.ResolutionMatch},
+			Values: []alexa.ResolutionItem{{Value: alexa.ResolvedValue{Name: id, ID: id}}},
+		}},
+	}}
+}
+
+func Test_FormResolutions(t *testing.T) {
+	var completed pizza
+	s := newSession(t, &form.Form[pizza]{
+		Name:    "pizza",
+		Intents: []string{"PizzaIntent"},
+		Fields: []*form.Field{
+			{Name: "Size", Prompt: "What size?"},
+			{Name: "Crust", Prompt: "What crust?"},
+			{Name: "Toppings", Prompt: "What toppings?"},
+			{Name: "Count", Prompt: "How many?"},
+		},
+		Complete: func(input askgo.HandlerInput, values *pizza) (*askgo.ResponseEnvelope, error) {
+			completed = *values
+			return input.GetResponse().Speak("Ordered"), nil
+		},
+	})
+
+	intent := alexa.NewIntent("PizzaIntent").SetSlotValues("Toppings", "shrooms", "olives")
+	intent.Slots["Size"] = resolved("Size", "big", "LARGE")
+	intent.Slots["Crust"] = resolved("Crust", "deep", "deep dish")
+	_, err := s.turnIntent(*intent)
+	require.NoError(t, err)
+
+	// a value that cannot be bound keeps the form
+	_, err = s.turn("AnswerIntent", map[string]string{"Count": "lots"})
+	var slotErr *askgo.SlotError
+	require.True(t, errors.As(err, &slotErr))
+	require.Contains(t, s.Attributes, form.SessionKeyPrefix+"pizza")
+
+	response, err := s.turn("AnswerIntent", map[string]string{"Count": "2"})
+	require.NoError(t, err)
+	require.Equal(t, "<speak>Ordered</speak>", response.Response.OutputSpeech.SSML)
+	require.Equal(t, pizza{Size: "LARGE", Crust: "deep dish", Toppings: []string{"shrooms", "olives"}, Count: 2}, completed)
+	require.Empty(t, s.Attributes)
+}
@@ -0,0 +1,280 @@
+// Package i18n provides locale aware message catalogs for skill responses.
+//
+// A catalog holds one set of messages per locale, usually loaded from YAML or JSON files
+// named after the locale (en-US.yaml, de.json, default.yaml) which can be embedded with
+// go:embed.  A message is either a plain string or a map of plural categories:
+//
+//	welcome: Welcome to the United States Quiz Game!
+//	score:
+//	  one: You got {count} question right.
+//	  other: You got {count} questions right.
+//
+// Lookups fall back from the requested locale to its language and then to the default
+// messages, so en-GB uses en-GB, en and default in that order.
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultLocale is the locale name of the messages used when nothing else matches
+const DefaultLocale = "default"
+
+// Message is a single catalog entry, keyed by plural category.  A message without
+// plural forms only has the Other category.
+type Message map[string]string
+
+// Params are the template parameters of a message, {name} in the message text is
+// replaced by the value of name.  The "count" parameter also selects the plural form.
+type Params map[string]interface{}
+
+// Catalog holds the messages of every locale
+type Catalog struct {
+	// Fallback is a locale tried after the language and before the default messages
+	Fallback string
+
+	messages map[string]map[string]Message
+	missing  sync.Map // locale and key of the missing messages already logged
+}
+
+// NewCatalog returns an empty catalog
+func NewCatalog() *Catalog {
+	return &Catalog{messages: map[string]map[string]Message{}}
+}
+
+// Set adds a message without plural forms
+func (c *Catalog) Set(locale, key, text string) *Catalog {
+	return c.SetMessage(locale, key, Message{Other: text})
+}
+
+// SetMessage adds a message with plural forms
+func (c *Catalog) SetMessage(locale, key string, message Message) *Catalog {
+	if c.messages[locale] == nil {
+		c.messages[locale] = map[string]Message{}
+	}
+	c.messages[locale][key] = message
+	return c
+}
+
+// Load adds the messages of a locale from YAML or JSON data
+func (c *Catalog) Load(locale string, data []byte) error {
+	raw := map[string]interface{}{}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("messages for %s: %v", locale, err)
+	}
+
+	for key, value := range raw {
+		switch v := value.(type) {
+		case string:
+			c.Set(locale, key, v)
+		case map[string]interface{}:
+			message := Message{}
+			for category, text := range v {
+				s, ok := text.(string)
+				if !ok {
+					return fmt.Errorf("messages for %s: %s.%s is not a string", locale, key, category)
+				}
+				message[category] = s
+			}
+			c.SetMessage(locale, key, message)
+		default:
+			return fmt.Errorf("messages for %s: %s must be a string or map of plural forms", locale, key)
+		}
+	}
+
+	return nil
+}
+
+// LoadFS adds every .yaml, .yml and .json file found in dir of the file system, using the
+// file name without extension as the locale.  Use it with an embed.FS to compile the
+// messages into the skill.
+func (c *Catalog) LoadFS(fsys fs.FS, dir string) error {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		ext := path.Ext(entry.Name())
+		if entry.IsDir() || (ext != ".yaml" && ext != ".yml" && ext != ".json") {
+			continue
+		}
+		data, err := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if err != nil {
+			return err
+		}
+		if err := c.Load(strings.TrimSuffix(entry.Name(), ext), data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// LoadDir builds a catalog from the message files of a directory
+func LoadDir(dir string) (*Catalog, error) {
+	c := NewCatalog()
+	if err := c.LoadFS(os.DirFS(dir), "."); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Locales returns the fallback chain of a locale: the locale itself, its language, the
+// catalog fallback locale and the default messages.
+func (c *Catalog) Locales(locale string) []string {
+	chain := []string{}
+	add := func(l string) {
+		if l == "" {
+			return
+		}
+		for _, existing := range chain {
+			if existing == l {
+				return
+			}
+		}
+		chain = append(chain, l)
+	}
+
+	add(locale)
+	add(language(locale))
+	if c != nil {
+		add(c.Fallback)
+		add(language(c.Fallback))
+	}
+	add(DefaultLocale)
+
+	return chain
+}
+
+// Lookup finds the message for a key following the fallback chain of the locale
+func (c *Catalog) Lookup(locale, key string) (Message, bool) {
+	if c == nil {
+		return nil, false
+	}
+	for _, l := range c.Locales(locale) {
+		if message, ok := c.messages[l][key]; ok {
+			return message, true
+		}
+	}
+	return nil, false
+}
+
+// Localizer returns a localizer bound to a locale
+func (c *Catalog) Localizer(locale string) *Localizer {
+	return &Localizer{catalog: c, locale: locale}
+}
+
+// Localizer renders catalog messages for a single locale
+type Localizer struct {
+	catalog *Catalog
+	locale  string
+}
+
+// Locale returns the locale the localizer was created for
+func (l *Localizer) Locale() string {
+	if l == nil {
+		return ""
+	}
+	return l.locale
+}
+
+// T renders the message for key with the template parameters.  When the key is not in
+// the catalog the key itself is returned so the missing message is easy to spot.
+func (l *Localizer) T(key string, params ...Params) string {
+	if l == nil {
+		return key
+	}
+
+	var merged Params
+	if len(params) > 0 {
+		merged = Params{}
+		for _, p := range params {
+			for k, v := range p {
+				merged[k] = v
+			}
+		}
+	}
+
+	message, ok := l.catalog.Lookup(l.locale, key)
+	if !ok {
+		l.catalog.logMissing(l.locale, key)
+		return key
+	}
+
+	text, ok := message[Other]
+	if count, isWhole := pluralCount(merged["count"]); isWhole {
+		if zero, found := message[Zero]; found && count == 0 {
+			text, ok = zero, true
+		} else if plural, found := message[PluralCategory(l.locale, count)]; found {
+			text, ok = plural, true
+		}
+	}
+	for _, category := range []string{One, Many, Few, Two, Zero} {
+		if ok {
+			break
+		}
+		text, ok = message[category]
+	}
+
+	return expand(text, merged)
+}
+
+// logMissing logs a key missing for a locale the first time it is asked for
+func (c *Catalog) logMissing(locale, key string) {
+	if c == nil {
+		return
+	}
+	if _, logged := c.missing.LoadOrStore(locale+"\x00"+key, true); !logged {
+		log.Printf("i18n: no message %q for locale %s", key, locale)
+	}
+}
+
+// expand replaces {name} placeholders with the matching parameter
+func expand(text string, params Params) string {
+	if len(params) == 0 || !strings.Contains(text, "{") {
+		return text
+	}
+
+	pairs := make([]string, 0, len(params)*2)
+	for k, v := range params {
+		pairs = append(pairs, "{"+k+"}", fmt.Sprint(v))
+	}
+	return strings.NewReplacer(pairs...).Replace(text)
+}
+
+// language returns the language part of a locale, en for en-US
+func language(locale string) string {
+	if n := strings.IndexAny(locale, "-_"); n > 0 {
+		return locale[:n]
+	}
+	return locale
+}
+
+type localizerKey struct{}
+
+// NewContext returns a context carrying the localizer
+func NewContext(ctx context.Context, l *Localizer) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, localizerKey{}, l)
+}
+
+// FromContext returns the localizer stored in the context, or nil if there is none
+func FromContext(ctx context.Context) *Localizer {
+	if ctx == nil {
+		return nil
+	}
+	l, _ := ctx.Value(localizerKey{}).(*Localizer)
+	return l
+}
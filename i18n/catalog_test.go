@@ -0,0 +1,89 @@
+package i18n_test
+
+import (
+	"bytes"
+	"log"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/spirilis/askgo/i18n"
+	"github.com/stretchr/testify/require"
+)
+
+func testCatalog(t *testing.T) *i18n.Catalog {
+	c := i18n.NewCatalog()
+	err := c.LoadFS(fstest.MapFS{
+		"messages/default.yaml": {Data: []byte("welcome: Welcome!\nbye: Goodbye.\n")},
+		"messages/en.yaml": {Data: []byte(`
+welcome: Welcome to the quiz, {name}!
+score:
+  zero: You have no points.
+  one: You have {count} point.
+  other: You have {count} points.
+`)},
+		"messages/en-GB.json": {Data: []byte(`{"bye": "Cheerio."}`)},
+		"messages/ru.yaml": {Data: []byte(`
+score:
+  one: "{count} очко"
+  few: "{count} очка"
+  many: "{count} очков"
+`)},
+		"messages/README.md": {Data: []byte("ignored")},
+	}, "messages")
+	require.NoError(t, err)
+	return c
+}
+
+func Test_Fallback(t *testing.T) {
+	c := testCatalog(t)
+
+	require.Equal(t, []string{"en-GB", "en", "default"}, c.Locales("en-GB"))
+
+	gb := c.Localizer("en-GB")
+	require.Equal(t, "Cheerio.", gb.T("bye"))
+	require.Equal(t, "Welcome to the quiz, Sam!", gb.T("welcome", i18n.Params{"name": "Sam"}))
+
+	require.Equal(t, "Goodbye.", c.Localizer("en-US").T("bye"))
+	require.Equal(t, "Welcome!", c.Localizer("de-DE").T("welcome"))
+	require.Equal(t, "missing", c.Localizer("de-DE").T("missing"))
+}
+
+func Test_Plural(t *testing.T) {
+	c := testCatalog(t)
+
+	en := c.Localizer("en-US")
+	require.Equal(t, "You have no points.", en.T("score", i18n.Params{"count": 0}))
+	require.Equal(t, "You have 1 point.", en.T("score", i18n.Params{"count": 1}))
+	require.Equal(t, "You have 7 points.", en.T("score", i18n.Params{"count": 7}))
+
+	ru := c.Localizer("ru-RU")
+	require.Equal(t, "21 очко", ru.T("score", i18n.Params{"count": 21}))
+	require.Equal(t, "3 очка", ru.T("score", i18n.Params{"count": 3}))
+	require.Equal(t, "12 очков", ru.T("score", i18n.Params{"count": 12}))
+
+	type points uint8
+	require.Equal(t, "You have 1 point.", en.T("score", i18n.Params{"count": int64(1)}))
+	require.Equal(t, "You have 1 point.", en.T("score", i18n.Params{"count": points(1)}))
+	require.Equal(t, "You have no points.", en.T("score", i18n.Params{"count": 0.0}))
+	require.Equal(t, "You have 1.5 points.", en.T("score", i18n.Params{"count": 1.5}))
+	require.Equal(t, "22 очка", ru.T("score", i18n.Params{"count": float32(22)}))
+}
+
+func Test_MissingLoggedOnce(t *testing.T) {
+	var out bytes.Buffer
+	defer log.SetOutput(log.Writer())
+	log.SetOutput(&out)
+
+	c := testCatalog(t)
+	c.Localizer("de-DE").T("missing")
+	c.Localizer("de-DE").T("missing")
+	c.Localizer("fr-FR").T("missing")
+
+	require.Equal(t, 2, strings.Count(out.String(), `no message "missing"`))
+}
+
+func Test_NilLocalizer(t *testing.T) {
+	var l *i18n.Localizer
+	require.Equal(t, "welcome", l.T("welcome"))
+}
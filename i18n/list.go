@@ -0,0 +1,55 @@
+package i18n
+
+import "strings"
+
+// ListPattern joins the items of a list: Pair joins a list of two items, Separator the
+// items of a longer list but the last, which is joined by Final
+type ListPattern struct {
+	Separator string
+	Final     string
+	Pair      string
+}
+
+// ListPatterns are the list patterns of the languages Alexa supports, keyed by locale or
+// language.  Locales and languages without an entry use the en pattern.
+var ListPatterns = map[string]ListPattern{
+	"en":    {Separator: ", ", Final: ", and ", Pair: " and "},
+	"en-AU": {Separator: ", ", Final: " and ", Pair: " and "},
+	"en-GB": {Separator: ", ", Final: " and ", Pair: " and "},
+	"en-IN": {Separator: ", ", Final: " and ", Pair: " and "},
+	"de":    {Separator: ", ", Final: " und ", Pair: " und "},
+	"es":    {Separator: ", ", Final: " y ", Pair: " y "},
+	"fr":    {Separator: ", ", Final: " et ", Pair: " et "},
+	"it":    {Separator: ", ", Final: " e ", Pair: " e "},
+	"pt":    {Separator: ", ", Final: " e ", Pair: " e "},
+	"nl":    {Separator: ", ", Final: " en ", Pair: " en "},
+	"hi":    {Separator: ", ", Final: " और ", Pair: " और "},
+	"ja":    {Separator: "、", Final: "、", Pair: "、"},
+}
+
+// JoinList joins the items into a spoken list in the style of the locale, "a, b, and c"
+// in en-US
+func JoinList(locale string, items []string) string {
+	pattern, ok := ListPatterns[locale]
+	if !ok {
+		if pattern, ok = ListPatterns[language(locale)]; !ok {
+			pattern = ListPatterns["en"]
+		}
+	}
+
+	switch len(items) {
+	case 0:
+		return ""
+	case 1:
+		return items[0]
+	case 2:
+		return items[0] + pattern.Pair + items[1]
+	}
+	last := len(items) - 1
+	return strings.Join(items[:last], pattern.Separator) + pattern.Final + items[last]
+}
+
+// List joins the items into a spoken list in the locale of the localizer
+func (l *Localizer) List(items []string) string {
+	return JoinList(l.Locale(), items)
+}
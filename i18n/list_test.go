@@ -0,0 +1,23 @@
+package i18n_test
+
+import (
+	"testing"
+
+	"github.com/spirilis/askgo/i18n"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_JoinList(t *testing.T) {
+	items := []string{"apples", "pears", "plums"}
+
+	require.Equal(t, "", i18n.JoinList("en-US", nil))
+	require.Equal(t, "apples", i18n.JoinList("en-US", items[:1]))
+	require.Equal(t, "apples and pears", i18n.JoinList("en-US", items[:2]))
+	require.Equal(t, "apples, pears, and plums", i18n.JoinList("en-US", items))
+	require.Equal(t, "apples, pears and plums", i18n.JoinList("en-GB", items))
+	require.Equal(t, "apples, pears und plums", i18n.JoinList("de-DE", items))
+	require.Equal(t, "apples、pears、plums", i18n.JoinList("ja-JP", items))
+	require.Equal(t, "apples, pears, and plums", i18n.JoinList("xx-YY", items))
+
+	require.Equal(t, "apples et pears", i18n.NewCatalog().Localizer("fr-FR").List(items[:2]))
+}
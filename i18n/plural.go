@@ -0,0 +1,134 @@
+package i18n
+
+import (
+	"math"
+	"reflect"
+)
+
+// Plural categories as defined by the Unicode CLDR
+const (
+	Zero  = "zero"
+	One   = "one"
+	Two   = "two"
+	Few   = "few"
+	Many  = "many"
+	Other = "other"
+)
+
+// PluralRule picks the plural category of a count
+type PluralRule func(n int) string
+
+// PluralRules are the integer plural rules of the languages Alexa supports, keyed by
+// language.  Languages without an entry use "one" for 1 and "other" for everything else.
+var PluralRules = map[string]PluralRule{
+	"en": pluralOneOther,
+	"de": pluralOneOther,
+	"es": pluralOneOther,
+	"it": pluralOneOther,
+	"nl": pluralOneOther,
+	"hi": pluralZeroOneOther,
+	"fr": pluralZeroOneOther,
+	"pt": pluralZeroOneOther,
+	"ja": pluralOther,
+	"ko": pluralOther,
+	"zh": pluralOther,
+	"ar": pluralArabic,
+	"pl": pluralPolish,
+	"ru": pluralRussian,
+	"uk": pluralRussian,
+}
+
+// PluralCategory returns the plural category of a count in a locale
+func PluralCategory(locale string, n int) string {
+	if rule, ok := PluralRules[language(locale)]; ok {
+		return rule(n)
+	}
+	return pluralOneOther(n)
+}
+
+// pluralCount converts the count parameter of a message to an int.  Any integer or
+// whole floating point number will do, fractions and other values have no count.
+func pluralCount(count interface{}) (int, bool) {
+	v := reflect.ValueOf(count)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return int(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		if f := v.Float(); f == math.Trunc(f) && !math.IsInf(f, 0) {
+			return int(f), true
+		}
+	}
+	return 0, false
+}
+
+func pluralOther(n int) string {
+	return Other
+}
+
+func pluralOneOther(n int) string {
+	if n == 1 {
+		return One
+	}
+	return Other
+}
+
+// French, Portuguese and Hindi treat 0 as singular
+func pluralZeroOneOther(n int) string {
+	if n == 0 || n == 1 {
+		return One
+	}
+	return Other
+}
+
+func pluralRussian(n int) string {
+	if n < 0 {
+		n = -n
+	}
+	mod10, mod100 := n%10, n%100
+	switch {
+	case mod10 == 1 && mod100 != 11:
+		return One
+	case mod10 >= 2 && mod10 <= 4 && (mod100 < 12 || mod100 > 14):
+		return Few
+	default:
+		return Many
+	}
+}
+
+func pluralPolish(n int) string {
+	if n < 0 {
+		n = -n
+	}
+	mod10, mod100 := n%10, n%100
+	switch {
+	case n == 1:
+		return One
+	case mod10 >= 2 && mod10 <= 4 && (mod100 < 12 || mod100 > 14):
+		return Few
+	default:
+		return Many
+	}
+}
+
+func pluralArabic(n int) string {
+	if n < 0 {
+		n = -n
+	}
+	mod100 := n % 100
+	switch {
+	case n == 0:
+		return Zero
+	case n == 1:
+		return One
+	case n == 2:
+		return Two
+	case mod100 >= 3 && mod100 <= 10:
+		return Few
+	case mod100 >= 11:
+		return Many
+	default:
+		return Other
+	}
+}
@@ -0,0 +1,85 @@
+// Package skilltest holds the fixtures shared by the tests of askgo and its packages.
+package skilltest
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/spirilis/askgo"
+	"github.com/spirilis/askgo/alexa"
+	"github.com/stretchr/testify/require"
+)
+
+// IntentHandler speaks Speech in response to the intent named Intent
+type IntentHandler struct {
+	Intent string
+	Speech string
+}
+
+// CanHandle accepts requests for the intent of the handler
+func (h *IntentHandler) CanHandle(input askgo.HandlerInput) bool {
+	return input.GetRequest().Intent.Name == h.Intent
+}
+
+// Handle speaks the speech of the handler
+func (h *IntentHandler) Handle(input askgo.HandlerInput) (*askgo.ResponseEnvelope, error) {
+	return input.GetResponse().Speak(h.Speech), nil
+}
+
+// IntentRequest returns the envelope of an IntentRequest for the intent in the locale
+func IntentRequest(locale, intent string) *askgo.RequestEnvelope {
+	return &askgo.RequestEnvelope{
+		Request: alexa.Request{Type: "IntentRequest", Locale: locale, Intent: alexa.Intent{Name: intent}},
+	}
+}
+
+// Session plays the requests of a single Alexa session, carrying the session attributes
+// of each response into the next request through JSON like Alexa does
+type Session struct {
+	// Skill processes the requests given to Process and Turn
+	Skill *askgo.Skill
+	// Attributes are the session attributes sent with the next request
+	Attributes map[string]interface{}
+
+	t testing.TB
+}
+
+// NewSession starts a session with the skill, which may be nil when the test calls its
+// handlers itself through Input and Keep
+func NewSession(t testing.TB, skill *askgo.Skill) *Session {
+	return &Session{Skill: skill, Attributes: map[string]interface{}{}, t: t}
+}
+
+// Input returns the handler input of the next request of the session
+func (s *Session) Input(envelope *askgo.RequestEnvelope) *askgo.DefaultHandler {
+	envelope.Session.Attributes = s.Attributes
+	return askgo.NewDefaultHandler(context.Background(), envelope)
+}
+
+// Keep stores the session attributes of the response for the next request.  A nil
+// response, when no handler took the request, leaves the attributes as they were.
+func (s *Session) Keep(response *askgo.ResponseEnvelope) {
+	if response == nil {
+		return
+	}
+	data, err := json.Marshal(response.SessionAttributes)
+	require.NoError(s.t, err)
+	s.Attributes = map[string]interface{}{}
+	require.NoError(s.t, json.Unmarshal(data, &s.Attributes))
+}
+
+// Process sends the request through the skill as the next turn of the session and
+// returns the response, nil when no handler took it
+func (s *Session) Process(envelope *askgo.RequestEnvelope) *askgo.ResponseEnvelope {
+	out, err := s.Skill.ProcessRequest(s.Input(envelope))
+	require.NoError(s.t, err)
+	response, _ := out.(*askgo.ResponseEnvelope)
+	s.Keep(response)
+	return response
+}
+
+// Turn processes an en-US IntentRequest for the intent
+func (s *Session) Turn(intent string) *askgo.ResponseEnvelope {
+	return s.Process(IntentRequest("en-US", intent))
+}
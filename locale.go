@@ -0,0 +1,119 @@
+package askgo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spirilis/askgo/alexa"
+	"github.com/spirilis/askgo/i18n"
+)
+
+// HandlerSet is a group of interceptors and handlers registered for a locale in Skill.Locales
+type HandlerSet struct {
+	RequestInterceptors  []RequestInterceptor
+	Handlers             []RequestHandler
+	ResponseInterceptors []ResponseInterceptor
+	ErrorHandlers        []ErrorHandler
+}
+
+// localeChain returns the keys of Skill.Locales to try for a locale, most specific first
+func localeChain(locale string) []string {
+	chain := []string{}
+	if locale != "" {
+		chain = append(chain, locale)
+	}
+	if n := strings.IndexAny(locale, "-_"); n > 0 {
+		chain = append(chain, locale[:n])
+	}
+	return chain
+}
+
+// handlerSet merges the handler sets matching the locale with the default set of the skill.
+//
+// Request interceptors run from the general to the specific (default, language, locale),
+// while handlers, response interceptors and error handlers are tried from the specific to
+// the general, so a locale handler is offered the request before the default handlers.
+func (skill *Skill) handlerSet(locale string) *HandlerSet {
+	set := &HandlerSet{}
+
+	var sets []*HandlerSet
+	for _, key := range localeChain(locale) {
+		if s, ok := skill.Locales[key]; ok && s != nil {
+			sets = append(sets, s)
+		}
+	}
+	sets = append(sets, &HandlerSet{
+		RequestInterceptors:  skill.RequestInterceptors,
+		Handlers:             skill.Handlers,
+		ResponseInterceptors: skill.ResponseInterceptors,
+		ErrorHandlers:        skill.ErrorHandlers,
+	})
+
+	for n := len(sets) - 1; n >= 0; n-- {
+		set.RequestInterceptors = append(set.RequestInterceptors, sets[n].RequestInterceptors...)
+	}
+	for _, s := range sets {
+		set.Handlers = append(set.Handlers, s.Handlers...)
+		set.ResponseInterceptors = append(set.ResponseInterceptors, s.ResponseInterceptors...)
+		set.ErrorHandlers = append(set.ErrorHandlers, s.ErrorHandlers...)
+	}
+
+	return set
+}
+
+// ValidateLocales checks that every intent of the interaction model, given as a map of
+// locale to intent names, is accepted by a handler for that locale.  It returns an error
+// listing the intents without a handler, or nil if every intent is handled.
+//
+// Each intent is offered to CanHandle of the handlers as a synthetic IntentRequest.  The
+// request interceptors are not run, so CanHandle must not depend on what they set up.
+func (skill *Skill) ValidateLocales(intents map[string][]string) error {
+	locales := make([]string, 0, len(intents))
+	for locale := range intents {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+
+	var problems []string
+	for _, locale := range locales {
+		set := skill.handlerSet(locale)
+
+		var missing []string
+		for _, intent := range intents[locale] {
+			if !set.canHandleIntent(skill, locale, intent) {
+				missing = append(missing, intent)
+			}
+		}
+		if len(missing) > 0 {
+			problems = append(problems, fmt.Sprintf("%s: no handler for %s", locale, strings.Join(missing, ", ")))
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("unhandled intents:\n\t%s", strings.Join(problems, "\n\t"))
+	}
+	return nil
+}
+
+func (set *HandlerSet) canHandleIntent(skill *Skill, locale, intent string) bool {
+	input := NewDefaultHandler(context.Background(), &RequestEnvelope{
+		Version: "1.0",
+		Request: alexa.Request{
+			Type:   "IntentRequest",
+			Locale: locale,
+			Intent: alexa.Intent{Name: intent},
+		},
+	})
+	if skill.Messages != nil {
+		input.SetContext(i18n.NewContext(input.GetContext(), skill.Messages.Localizer(locale)))
+	}
+
+	for _, handler := range set.Handlers {
+		if handler.CanHandle(input) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,61 @@
+package askgo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spirilis/askgo"
+	"github.com/spirilis/askgo/internal/skilltest"
+	"github.com/stretchr/testify/require"
+)
+
+type countingInterceptor struct {
+	calls int
+}
+
+func (c *countingInterceptor) Process(input askgo.HandlerInput) error {
+	c.calls++
+	return nil
+}
+
+func Test_LocaleRouting(t *testing.T) {
+	interceptor := &countingInterceptor{}
+	skill := &askgo.Skill{
+		IgnoreTimestamp:     true,
+		RequestInterceptors: []askgo.RequestInterceptor{interceptor},
+		Handlers: []askgo.RequestHandler{
+			&skilltest.IntentHandler{Intent: "QuizIntent", Speech: "quiz"},
+			&skilltest.IntentHandler{Intent: "AnswerIntent", Speech: "answer"},
+		},
+		Locales: map[string]*askgo.HandlerSet{
+			"de":    {Handlers: []askgo.RequestHandler{&skilltest.IntentHandler{Intent: "QuizIntent", Speech: "quiz de"}}},
+			"ja-JP": {Handlers: []askgo.RequestHandler{&skilltest.IntentHandler{Intent: "CountIntent", Speech: "count"}}},
+		},
+	}
+
+	speech := func(locale, intent string) string {
+		out, err := skill.ProcessRequest(askgo.NewDefaultHandler(context.Background(), skilltest.IntentRequest(locale, intent)))
+		require.NoError(t, err)
+		return out.(*askgo.ResponseEnvelope).Response.OutputSpeech.SSML
+	}
+
+	require.Equal(t, "<speak>quiz de</speak>", speech("de-DE", "QuizIntent"))
+	require.Equal(t, "<speak>answer</speak>", speech("de-DE", "AnswerIntent"))
+	require.Equal(t, "<speak>quiz</speak>", speech("en-US", "QuizIntent"))
+	require.Equal(t, "<speak>count</speak>", speech("ja-JP", "CountIntent"))
+	require.Equal(t, 4, interceptor.calls)
+
+	require.NoError(t, skill.ValidateLocales(map[string][]string{
+		"de-DE": {"QuizIntent", "AnswerIntent"},
+		"ja-JP": {"CountIntent"},
+	}))
+
+	err := skill.ValidateLocales(map[string][]string{
+		"en-US": {"QuizIntent", "CountIntent"},
+		"de-DE": {"HelpIntent"},
+	})
+	require.EqualError(t, err, "unhandled intents:\n\tde-DE: no handler for HelpIntent\n\ten-US: no handler for CountIntent")
+
+	// validation only asks CanHandle, the interceptors are left alone
+	require.Equal(t, 4, interceptor.calls)
+}
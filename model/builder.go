@@ -0,0 +1,331 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spirilis/askgo"
+)
+
+// Builder defines an interaction model in Go, next to the handlers that serve it, and
+// emits the interaction model JSON for each locale.
+//
+//	m := model.New("quiz game")
+//	m.Intent("AnswerIntent").
+//		Samples("{StateName}", "tell me about {StateName}").
+//		Slot("StateName", "AMAZON.US_STATE").
+//		Handler(&answerHandler{})
+//	m.Intent("AMAZON.HelpIntent").Handler(&helpHandler{})
+//
+//	data, err := m.JSON("en-US")
+type Builder struct {
+	invocationName string
+	invocations    map[string]string
+	intents        []*IntentBuilder
+	types          []*TypeBuilder
+}
+
+// IntentBuilder defines a single intent of a Builder
+type IntentBuilder struct {
+	model   *Builder
+	name    string
+	samples []string
+	// locale specific samples replace the default samples in that locale
+	localeSamples map[string][]string
+	// if non-empty the intent is only part of these locales
+	locales []string
+	slots   []Slot
+	handler askgo.RequestHandler
+}
+
+// TypeBuilder defines a custom slot type of a Builder
+type TypeBuilder struct {
+	model  *Builder
+	name   string
+	values []SlotTypeValue
+}
+
+// New starts an interaction model with the invocation name used for every locale that
+// does not set its own with Invocation.
+func New(invocationName string) *Builder {
+	return &Builder{
+		invocationName: invocationName,
+		invocations:    map[string]string{},
+	}
+}
+
+// Invocation sets the invocation name for a single locale
+func (b *Builder) Invocation(locale, invocationName string) *Builder {
+	b.invocations[locale] = invocationName
+	return b
+}
+
+// InvocationName returns the invocation name used for a locale
+func (b *Builder) InvocationName(locale string) string {
+	if name, ok := b.invocations[locale]; ok {
+		return name
+	}
+	return b.invocationName
+}
+
+// Intent returns the definition of the named intent, adding it to the model if needed
+func (b *Builder) Intent(name string) *IntentBuilder {
+	for _, i := range b.intents {
+		if i.name == name {
+			return i
+		}
+	}
+	i := &IntentBuilder{model: b, name: name, localeSamples: map[string][]string{}}
+	b.intents = append(b.intents, i)
+	return i
+}
+
+// Type returns the definition of the named custom slot type, adding it to the model if needed
+func (b *Builder) Type(name string) *TypeBuilder {
+	for _, t := range b.types {
+		if t.name == name {
+			return t
+		}
+	}
+	t := &TypeBuilder{model: b, name: name}
+	b.types = append(b.types, t)
+	return t
+}
+
+// IntentNames returns the names of the intents that are part of the locale
+func (b *Builder) IntentNames(locale string) []string {
+	var names []string
+	for _, i := range b.intents {
+		if i.inLocale(locale) {
+			names = append(names, i.name)
+		}
+	}
+	return names
+}
+
+// Locales returns every locale mentioned by the definitions, sorted.  A model that
+// never names a locale returns an empty list.
+func (b *Builder) Locales() []string {
+	seen := map[string]bool{}
+	for l := range b.invocations {
+		seen[l] = true
+	}
+	for _, i := range b.intents {
+		for l := range i.localeSamples {
+			seen[l] = true
+		}
+		for _, l := range i.locales {
+			seen[l] = true
+		}
+	}
+
+	locales := make([]string, 0, len(seen))
+	for l := range seen {
+		locales = append(locales, l)
+	}
+	sort.Strings(locales)
+	return locales
+}
+
+// LanguageModel assembles the language model for a locale without validating it
+func (b *Builder) LanguageModel(locale string) LanguageModel {
+	lm := LanguageModel{
+		InvocationName: b.InvocationName(locale),
+		Intents:        []Intent{},
+	}
+
+	for _, i := range b.intents {
+		if !i.inLocale(locale) {
+			continue
+		}
+		samples, ok := i.localeSamples[locale]
+		if !ok {
+			samples = i.samples
+		}
+		if samples == nil {
+			samples = []string{}
+		}
+		lm.Intents = append(lm.Intents, Intent{
+			Name:    i.name,
+			Samples: samples,
+			Slots:   i.slots,
+		})
+	}
+
+	for _, t := range b.types {
+		lm.Types = append(lm.Types, SlotType{Name: t.name, Values: t.values})
+	}
+
+	return lm
+}
+
+// Build assembles and validates the interaction model for a locale.  All validation
+// problems are returned together in a single error.
+func (b *Builder) Build(locale string) (*Document, error) {
+	lm := b.LanguageModel(locale)
+
+	if errs := lm.Validate(); len(errs) > 0 {
+		msgs := make([]string, len(errs))
+		for n, err := range errs {
+			msgs[n] = err.Error()
+		}
+		return nil, fmt.Errorf("interaction model for %s is invalid:\n\t%s", locale, strings.Join(msgs, "\n\t"))
+	}
+
+	return &Document{InteractionModel: InteractionModel{LanguageModel: lm}}, nil
+}
+
+// JSON builds the interaction model for a locale and encodes it in the format used by
+// the developer console.
+func (b *Builder) JSON(locale string) ([]byte, error) {
+	doc, err := b.Build(locale)
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+// Handlers derives the routing table for Skill.Handlers from the intents that were given
+// a handler, in the order the intents were defined.
+func (b *Builder) Handlers() []askgo.RequestHandler {
+	var handlers []askgo.RequestHandler
+	for _, i := range b.intents {
+		if i.handler != nil {
+			handlers = append(handlers, &intentRoute{name: i.name, handler: i.handler})
+		}
+	}
+	return handlers
+}
+
+// ValidateLocales checks that the skill has a handler for every intent of the model in the
+// given locales, or in every locale of the model when none are given, see
+// askgo.Skill.ValidateLocales.
+func (b *Builder) ValidateLocales(skill *askgo.Skill, locales ...string) error {
+	if len(locales) == 0 {
+		locales = b.Locales()
+	}
+	intents := map[string][]string{}
+	for _, l := range locales {
+		intents[l] = b.IntentNames(l)
+	}
+	return skill.ValidateLocales(intents)
+}
+
+func (i *IntentBuilder) inLocale(locale string) bool {
+	if len(i.locales) == 0 {
+		return true
+	}
+	for _, l := range i.locales {
+		if l == locale {
+			return true
+		}
+	}
+	return false
+}
+
+// Samples adds sample utterances used in every locale without its own samples
+func (i *IntentBuilder) Samples(samples ...string) *IntentBuilder {
+	i.samples = append(i.samples, samples...)
+	return i
+}
+
+// LocaleSamples adds sample utterances for a single locale
+func (i *IntentBuilder) LocaleSamples(locale string, samples ...string) *IntentBuilder {
+	i.localeSamples[locale] = append(i.localeSamples[locale], samples...)
+	return i
+}
+
+// Locales limits the intent to the given locales, by default it is part of every locale
+func (i *IntentBuilder) Locales(locales ...string) *IntentBuilder {
+	i.locales = append(i.locales, locales...)
+	return i
+}
+
+// Slot adds a slot of the given type, with optional samples used when Alexa elicits it
+func (i *IntentBuilder) Slot(name, slotType string, samples ...string) *IntentBuilder {
+	i.slots = append(i.slots, Slot{Name: name, Type: slotType, Samples: samples})
+	return i
+}
+
+// Handler attaches the request handler for the intent.  The derived route only offers the
+// request to the handler when the intent name matches.
+func (i *IntentBuilder) Handler(handler askgo.RequestHandler) *IntentBuilder {
+	i.handler = handler
+	return i
+}
+
+// Handle attaches a function as the request handler for the intent
+func (i *IntentBuilder) Handle(fn func(input askgo.HandlerInput) (*askgo.ResponseEnvelope, error)) *IntentBuilder {
+	return i.Handler(handlerFunc(fn))
+}
+
+// Intent continues the chain with another intent of the same model
+func (i *IntentBuilder) Intent(name string) *IntentBuilder {
+	return i.model.Intent(name)
+}
+
+// Type continues the chain with a custom slot type of the same model
+func (i *IntentBuilder) Type(name string) *TypeBuilder {
+	return i.model.Type(name)
+}
+
+// Model returns the Builder the intent belongs to
+func (i *IntentBuilder) Model() *Builder {
+	return i.model
+}
+
+// Value adds a value with optional synonyms to the slot type
+func (t *TypeBuilder) Value(value string, synonyms ...string) *TypeBuilder {
+	return t.ValueWithID("", value, synonyms...)
+}
+
+// ValueWithID adds a value with an entity resolution ID and optional synonyms to the slot type
+func (t *TypeBuilder) ValueWithID(id, value string, synonyms ...string) *TypeBuilder {
+	t.values = append(t.values, SlotTypeValue{
+		ID:   id,
+		Name: SlotTypeValueName{Value: value, Synonyms: synonyms},
+	})
+	return t
+}
+
+// Intent continues the chain with an intent of the same model
+func (t *TypeBuilder) Intent(name string) *IntentBuilder {
+	return t.model.Intent(name)
+}
+
+// Type continues the chain with another custom slot type of the same model
+func (t *TypeBuilder) Type(name string) *TypeBuilder {
+	return t.model.Type(name)
+}
+
+// Model returns the Builder the slot type belongs to
+func (t *TypeBuilder) Model() *Builder {
+	return t.model
+}
+
+type handlerFunc func(input askgo.HandlerInput) (*askgo.ResponseEnvelope, error)
+
+func (f handlerFunc) CanHandle(input askgo.HandlerInput) bool {
+	return true
+}
+
+func (f handlerFunc) Handle(input askgo.HandlerInput) (*askgo.ResponseEnvelope, error) {
+	return f(input)
+}
+
+// intentRoute offers IntentRequests for a single intent to a handler
+type intentRoute struct {
+	name    string
+	handler askgo.RequestHandler
+}
+
+func (r *intentRoute) CanHandle(input askgo.HandlerInput) bool {
+	request := input.GetRequest()
+	return request.Type == "IntentRequest" && request.Intent.Name == r.name && r.handler.CanHandle(input)
+}
+
+func (r *intentRoute) Handle(input askgo.HandlerInput) (*askgo.ResponseEnvelope, error) {
+	return r.handler.Handle(input)
+}
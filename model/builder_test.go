@@ -0,0 +1,99 @@
+package model_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/spirilis/askgo"
+	"github.com/spirilis/askgo/alexa"
+	"github.com/spirilis/askgo/model"
+	"github.com/stretchr/testify/require"
+)
+
+func quizModel() *model.Builder {
+	m := model.New("quiz game")
+	m.Intent("AnswerIntent").
+		Samples("{StateName}", "tell me about {StateName}").
+		Slot("StateName", "AMAZON.US_STATE").
+		Slot("Abbreviation", "US_STATE_ABBR").
+		Handle(func(input askgo.HandlerInput) (*askgo.ResponseEnvelope, error) {
+			return input.GetResponse().Speak("answer"), nil
+		}).
+		Intent("QuizIntent").
+		Samples("start a quiz").
+		LocaleSamples("de-DE", "starte ein quiz").
+		Type("US_STATE_ABBR").
+		Value("AK").
+		Value("AL")
+	m.Invocation("de-DE", "quiz spiel")
+	return m
+}
+
+func Test_BuildJSON(t *testing.T) {
+	data, err := quizModel().JSON("de-DE")
+	require.NoError(t, err)
+
+	doc := new(model.Document)
+	require.NoError(t, json.Unmarshal(data, doc))
+
+	lm := doc.InteractionModel.LanguageModel
+	require.Equal(t, "quiz spiel", lm.InvocationName)
+	require.Len(t, lm.Intents, 2)
+	require.Equal(t, []string{"starte ein quiz"}, lm.Intent("QuizIntent").Samples)
+	require.Len(t, lm.Type("US_STATE_ABBR").Values, 2)
+}
+
+func Test_BuildInvalid(t *testing.T) {
+	m := model.New("Ask Quiz")
+	m.Intent("AnswerIntent").Samples("tell me about {State", "what is {Missing}", "question 1")
+
+	_, err := m.Build("en-US")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "lower case")
+	require.Contains(t, err.Error(), `"Ask"`)
+	require.Contains(t, err.Error(), "unmatched '{'")
+	require.Contains(t, err.Error(), "undefined slot {Missing}")
+	require.Contains(t, err.Error(), "spell out numbers")
+}
+
+func Test_ValidateBuiltIns(t *testing.T) {
+	m := model.New("state quiz")
+	m.Intent("AMAZON.HelpIntent")
+	m.Intent("AMAZON.SendToPhoneIntent")
+	m.Intent("AMAZON.MadeUpIntent")
+	m.Intent("AnswerIntent").
+		Samples("is it {StateName}", "is the answer {StateName}", "my guess is {StateName}", "i think {StateName}", "try {Region}").
+		Slot("StateName", "AMAZON.US_STATE").
+		Slot("Region", "AMAZON.GB_REGION").
+		Slot("Capital", "AMAZON.CAPITAL_CITY", "the capital is {Capital}")
+
+	lm := m.LanguageModel("en-US")
+	require.Len(t, lm.Validate(), 2)
+	require.Len(t, lm.ValidateLocale("ja-JP"), 2)
+}
+
+func Test_Handlers(t *testing.T) {
+	handlers := quizModel().Handlers()
+	require.Len(t, handlers, 1)
+
+	envelope := &askgo.RequestEnvelope{Request: alexa.Request{Type: "IntentRequest", Intent: alexa.Intent{Name: "AnswerIntent"}}}
+	input := askgo.NewDefaultHandler(nil, envelope)
+	require.True(t, handlers[0].CanHandle(input))
+
+	envelope.Request.Intent.Name = "QuizIntent"
+	require.False(t, handlers[0].CanHandle(input))
+}
+
+func Test_ValidateLocales(t *testing.T) {
+	m := quizModel()
+	skill := &askgo.Skill{Handlers: m.Handlers()}
+
+	require.EqualError(t, m.ValidateLocales(skill), "unhandled intents:\n\tde-DE: no handler for QuizIntent")
+	require.EqualError(t, m.ValidateLocales(skill, "en-US"), "unhandled intents:\n\ten-US: no handler for QuizIntent")
+
+	m.Intent("QuizIntent").Handle(func(input askgo.HandlerInput) (*askgo.ResponseEnvelope, error) {
+		return input.GetResponse().Speak("quiz"), nil
+	})
+	skill.Handlers = m.Handlers()
+	require.NoError(t, m.ValidateLocales(skill, "de-DE", "en-US"))
+}
@@ -0,0 +1,269 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Lint rule names reported in Finding.Rule
+const (
+	RuleInvocationName    = "invocation-name"
+	RuleIllegalCharacter  = "illegal-character"
+	RuleSharedUtterance   = "shared-utterance"
+	RuleAmbiguousSamples  = "ambiguous-samples"
+	RuleSlotOnlySample    = "slot-only-sample"
+	RuleUnusedSlot        = "unused-slot"
+	RuleTooFewSamples     = "too-few-samples"
+	RuleUndefinedSlotType = "undefined-slot-type"
+	RuleExpansionLimit    = "expansion-limit"
+)
+
+// Finding is a single problem reported by Lint
+type Finding struct {
+	Rule    string
+	Intent  string
+	Message string
+}
+
+func (f Finding) String() string {
+	if f.Intent == "" {
+		return fmt.Sprintf("%s: %s", f.Rule, f.Message)
+	}
+	return fmt.Sprintf("%s: %s: %s", f.Rule, f.Intent, f.Message)
+}
+
+// LintOptions tune the checks made by Lint
+type LintOptions struct {
+	// MinSamples is the fewest samples a custom intent should have, 0 uses the default of 5
+	// and a negative value turns the too-few-samples check off
+	MinSamples int
+	// MaxExpansions caps the utterances generated from a single sample when custom slot
+	// values are substituted, 0 uses the default of 1000
+	MaxExpansions int
+}
+
+// Lint expands the sample utterances of a language model, substituting the values and
+// synonyms of custom slot types, and reports utterances that will mis-route or are
+// otherwise of poor quality.
+func Lint(lm *LanguageModel, options LintOptions) []Finding {
+	if options.MinSamples == 0 {
+		options.MinSamples = 5
+	}
+	if options.MaxExpansions == 0 {
+		options.MaxExpansions = 1000
+	}
+
+	var findings []Finding
+
+	for _, err := range ValidateInvocationName(lm.InvocationName) {
+		findings = append(findings, Finding{Rule: RuleInvocationName, Message: err.Error()})
+	}
+
+	// utterance -> intents that can produce it
+	utterances := map[string]map[string]bool{}
+	// sample shape with slots blanked -> samples
+	shapes := map[string][]sampleRef{}
+
+	for _, intent := range lm.Intents {
+		builtin := strings.HasPrefix(intent.Name, "AMAZON.")
+		if !builtin && options.MinSamples > 0 && len(intent.Samples) < options.MinSamples {
+			findings = append(findings, Finding{
+				Rule:    RuleTooFewSamples,
+				Intent:  intent.Name,
+				Message: fmt.Sprintf("has %d samples, at least %d are recommended", len(intent.Samples), options.MinSamples),
+			})
+		}
+
+		slotTypes := map[string]string{}
+		used := map[string]bool{}
+		for _, s := range intent.Slots {
+			slotTypes[s.Name] = s.Type
+			if !strings.HasPrefix(s.Type, "AMAZON.") && lm.Type(s.Type) == nil {
+				findings = append(findings, Finding{
+					Rule:    RuleUndefinedSlotType,
+					Intent:  intent.Name,
+					Message: fmt.Sprintf("slot %s has undefined type %s", s.Name, s.Type),
+				})
+			}
+		}
+
+		for _, sample := range intent.Samples {
+			names, err := SampleSlots(sample)
+			if err != nil {
+				findings = append(findings, Finding{Rule: RuleIllegalCharacter, Intent: intent.Name, Message: err.Error()})
+				continue
+			}
+			if r := sampleIllegalRune(sample); r != 0 {
+				findings = append(findings, Finding{
+					Rule:    RuleIllegalCharacter,
+					Intent:  intent.Name,
+					Message: fmt.Sprintf("sample %q contains illegal character %q", sample, r),
+				})
+			}
+			for _, name := range names {
+				used[name] = true
+			}
+
+			shape := sampleShape(sample)
+			if len(names) > 0 && strings.TrimSpace(strings.ReplaceAll(shape, "{}", "")) == "" {
+				findings = append(findings, Finding{
+					Rule:    RuleSlotOnlySample,
+					Intent:  intent.Name,
+					Message: fmt.Sprintf("sample %q has no carrier phrase and will match almost anything", sample),
+				})
+			}
+			if len(names) > 0 {
+				shapes[shape] = append(shapes[shape], sampleRef{intent: intent.Name, sample: sample})
+			}
+
+			expanded, complete := expandSample(lm, sample, slotTypes, options.MaxExpansions)
+			if !complete {
+				findings = append(findings, Finding{
+					Rule:    RuleExpansionLimit,
+					Intent:  intent.Name,
+					Message: fmt.Sprintf("sample %q expands to more than %d utterances, only the first %d were checked", sample, options.MaxExpansions, options.MaxExpansions),
+				})
+			}
+			for _, u := range expanded {
+				if utterances[u] == nil {
+					utterances[u] = map[string]bool{}
+				}
+				utterances[u][intent.Name] = true
+			}
+		}
+
+		for _, s := range intent.Slots {
+			if !used[s.Name] && len(s.Samples) == 0 {
+				findings = append(findings, Finding{
+					Rule:    RuleUnusedSlot,
+					Intent:  intent.Name,
+					Message: fmt.Sprintf("slot %s is not used in any sample", s.Name),
+				})
+			}
+		}
+	}
+
+	for _, u := range sortedKeys(utterances) {
+		if len(utterances[u]) < 2 {
+			continue
+		}
+		intents := sortedKeys(utterances[u])
+		findings = append(findings, Finding{
+			Rule:    RuleSharedUtterance,
+			Intent:  strings.Join(intents, ", "),
+			Message: fmt.Sprintf("utterance %q is shared between intents", u),
+		})
+	}
+
+	for _, shape := range sortedKeys(shapes) {
+		refs := shapes[shape]
+		if len(refs) < 2 {
+			continue
+		}
+		samples := make([]string, len(refs))
+		intents := map[string]bool{}
+		for n, r := range refs {
+			samples[n] = fmt.Sprintf("%q", r.sample)
+			intents[r.intent] = true
+		}
+		findings = append(findings, Finding{
+			Rule:    RuleAmbiguousSamples,
+			Intent:  strings.Join(sortedKeys(intents), ", "),
+			Message: fmt.Sprintf("samples %s cannot be told apart once their slots are filled", strings.Join(samples, ", ")),
+		})
+	}
+
+	return findings
+}
+
+type sampleRef struct {
+	intent string
+	sample string
+}
+
+// normalizeUtterance lower cases an utterance and collapses its whitespace
+func normalizeUtterance(s string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(s), unicode.IsSpace), " ")
+}
+
+// sampleShape replaces every slot reference of a sample with {}
+func sampleShape(sample string) string {
+	var out strings.Builder
+	inSlot := false
+	for _, r := range sample {
+		switch {
+		case r == '{':
+			inSlot = true
+			out.WriteString("{}")
+		case r == '}':
+			inSlot = false
+		case !inSlot:
+			out.WriteRune(r)
+		}
+	}
+	return normalizeUtterance(out.String())
+}
+
+// expandSample substitutes the values and synonyms of custom slot types into a sample.
+// Slots of built-in types are left as a {AMAZON.TYPE} placeholder.  At most limit
+// utterances are generated, complete is false when the sample expands to more.
+func expandSample(lm *LanguageModel, sample string, slotTypes map[string]string, limit int) (results []string, complete bool) {
+	results = []string{""}
+	complete = true
+
+	rest := sample
+	for rest != "" {
+		open := strings.Index(rest, "{")
+		if open < 0 {
+			open = len(rest)
+		}
+		for n := range results {
+			results[n] += rest[:open]
+		}
+		if open == len(rest) {
+			break
+		}
+		end := strings.Index(rest[open:], "}")
+		name := rest[open+1 : open+end]
+		rest = rest[open+end+1:]
+
+		var values []string
+		if t := lm.Type(slotTypes[name]); t != nil {
+			for _, v := range t.Values {
+				values = append(values, v.Name.Value)
+				values = append(values, v.Name.Synonyms...)
+			}
+		}
+		if len(values) == 0 {
+			values = []string{"{" + slotTypes[name] + "}"}
+		}
+
+		var next []string
+		for _, r := range results {
+			for _, v := range values {
+				if len(next) == limit {
+					complete = false
+					break
+				}
+				next = append(next, r+v)
+			}
+		}
+		results = next
+	}
+
+	for n := range results {
+		results[n] = normalizeUtterance(results[n])
+	}
+	return results, complete
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,88 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/spirilis/askgo/model"
+	"github.com/stretchr/testify/require"
+)
+
+func findingRules(findings []model.Finding) map[string]int {
+	rules := map[string]int{}
+	for _, f := range findings {
+		rules[f.Rule]++
+	}
+	return rules
+}
+
+func Test_Lint(t *testing.T) {
+	m := model.New("ask quiz")
+	m.Intent("OrderIntent").
+		Samples("order {Drink}", "i want {Drink}", "get me {Drink}", "a {Drink} please", "bring {Drink}").
+		Slot("Drink", "DRINK").
+		Slot("Size", "AMAZON.NUMBER")
+	m.Intent("CoffeeIntent").
+		Samples("order coffee", "give me a coffee!").
+		Type("DRINK").
+		Value("tea").
+		Value("coffee", "java")
+
+	lm := m.LanguageModel("en-US")
+	findings := model.Lint(&lm, model.LintOptions{})
+	rules := findingRules(findings)
+
+	require.Equal(t, 1, rules[model.RuleInvocationName])
+	require.Equal(t, 1, rules[model.RuleSharedUtterance])
+	require.Equal(t, 1, rules[model.RuleUnusedSlot])
+	require.Equal(t, 1, rules[model.RuleTooFewSamples])
+	require.Equal(t, 1, rules[model.RuleIllegalCharacter])
+	require.Zero(t, rules[model.RuleAmbiguousSamples])
+
+	rules = findingRules(model.Lint(&lm, model.LintOptions{MinSamples: -1}))
+	require.Zero(t, rules[model.RuleTooFewSamples])
+
+	for _, f := range findings {
+		if f.Rule == model.RuleSharedUtterance {
+			require.Equal(t, "CoffeeIntent, OrderIntent", f.Intent)
+			require.Contains(t, f.Message, `"order coffee"`)
+		}
+	}
+}
+
+func Test_LintExpansionLimit(t *testing.T) {
+	m := model.New("drink order")
+	m.Intent("OrderIntent").
+		Samples("order {Size} {Drink}", "i want a {Size} {Drink}", "get me a {Drink}", "a {Drink} please", "bring me a {Drink}").
+		Slot("Size", "SIZE").
+		Slot("Drink", "DRINK").
+		Type("SIZE").
+		Value("small").
+		Value("large", "big").
+		Type("DRINK").
+		Value("tea").
+		Value("coffee", "java")
+
+	lm := m.LanguageModel("en-US")
+	require.Empty(t, model.Lint(&lm, model.LintOptions{}))
+
+	findings := model.Lint(&lm, model.LintOptions{MaxExpansions: 4})
+	require.Len(t, findings, 2)
+	require.Equal(t, model.RuleExpansionLimit, findings[0].Rule)
+	require.Equal(t, "OrderIntent", findings[0].Intent)
+	require.Contains(t, findings[0].Message, `"order {Size} {Drink}" expands to more than 4 utterances`)
+}
+
+func Test_LintAmbiguous(t *testing.T) {
+	m := model.New("state quiz")
+	m.Intent("AnswerIntent").
+		Samples("{StateName}", "tell me about {StateName}", "tell me about {Capital}", "{Capital} please", "the answer is {StateName}").
+		Slot("StateName", "AMAZON.US_STATE").
+		Slot("Capital", "AMAZON.US_CITY")
+
+	lm := m.LanguageModel("en-US")
+	rules := findingRules(model.Lint(&lm, model.LintOptions{}))
+
+	require.Equal(t, 1, rules[model.RuleSlotOnlySample])
+	require.Equal(t, 1, rules[model.RuleAmbiguousSamples])
+	require.Len(t, rules, 2)
+}
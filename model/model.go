@@ -0,0 +1,101 @@
+// Package model describes the Alexa interaction model, the JSON document that is
+// edited in the developer console (or deployed with the ASK CLI) for each locale.
+package model
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+)
+
+// Document is the top level of an interaction model JSON file
+type Document struct {
+	InteractionModel InteractionModel `json:"interactionModel"`
+}
+
+// InteractionModel contains the language model along with the optional dialog model and prompts.
+// The dialog and prompts are kept as raw JSON so they survive a load and save unchanged.
+type InteractionModel struct {
+	LanguageModel LanguageModel   `json:"languageModel"`
+	Dialog        json.RawMessage `json:"dialog,omitempty"`
+	Prompts       json.RawMessage `json:"prompts,omitempty"`
+}
+
+// LanguageModel is the invocation name, intents and custom slot types for one locale.
+type LanguageModel struct {
+	InvocationName string     `json:"invocationName"`
+	Intents        []Intent   `json:"intents"`
+	Types          []SlotType `json:"types,omitempty"`
+}
+
+// Intent is a single intent with its sample utterances and slots
+type Intent struct {
+	Name    string   `json:"name"`
+	Samples []string `json:"samples"`
+	Slots   []Slot   `json:"slots,omitempty"`
+}
+
+// Slot is a slot definition on an intent
+type Slot struct {
+	Name    string   `json:"name"`
+	Type    string   `json:"type"`
+	Samples []string `json:"samples,omitempty"`
+}
+
+// SlotType is a custom slot type and its values
+type SlotType struct {
+	Name   string          `json:"name"`
+	Values []SlotTypeValue `json:"values"`
+}
+
+// SlotTypeValue is a single value of a custom slot type
+type SlotTypeValue struct {
+	ID   string            `json:"id,omitempty"`
+	Name SlotTypeValueName `json:"name"`
+}
+
+// SlotTypeValueName is the canonical value along with any synonyms
+type SlotTypeValueName struct {
+	Value    string   `json:"value"`
+	Synonyms []string `json:"synonyms,omitempty"`
+}
+
+// Read decodes an interaction model document
+func Read(r io.Reader) (*Document, error) {
+	doc := new(Document)
+	if err := json.NewDecoder(r).Decode(doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// Load reads the interaction model document from the named file
+func Load(path string) (*Document, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return Read(f)
+}
+
+// Intent returns the named intent, or nil if the language model does not define it
+func (m *LanguageModel) Intent(name string) *Intent {
+	for i := range m.Intents {
+		if m.Intents[i].Name == name {
+			return &m.Intents[i]
+		}
+	}
+	return nil
+}
+
+// Type returns the named custom slot type, or nil if the language model does not define it
+func (m *LanguageModel) Type(name string) *SlotType {
+	for i := range m.Types {
+		if m.Types[i].Name == name {
+			return &m.Types[i]
+		}
+	}
+	return nil
+}
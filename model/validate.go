@@ -0,0 +1,230 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/spirilis/askgo/alexa"
+)
+
+// Words that are not allowed in an invocation name because they are launch phrases,
+// connecting words or wake words.
+var invocationReservedWords = map[string]bool{
+	"alexa": true, "amazon": true, "echo": true, "computer": true, "skill": true, "app": true,
+	"ask": true, "tell": true, "open": true, "launch": true, "load": true, "begin": true,
+	"enable": true, "run": true, "resume": true, "start": true, "use": true, "play": true,
+}
+
+// ValidateInvocationName checks an invocation name against the Alexa rules, returning one
+// error per rule that is broken.
+func ValidateInvocationName(name string) []error {
+	var errs []error
+
+	if strings.TrimSpace(name) == "" {
+		return []error{errors.New("invocation name is empty")}
+	}
+	if len(name) < 2 || len(name) > 50 {
+		errs = append(errs, fmt.Errorf("invocation name %q must be between 2 and 50 characters", name))
+	}
+
+	words := strings.Fields(name)
+	if len(words) < 2 {
+		errs = append(errs, fmt.Errorf("invocation name %q must be at least two words", name))
+	}
+	if strings.Join(words, " ") != name {
+		errs = append(errs, fmt.Errorf("invocation name %q has extra whitespace", name))
+	}
+
+	for _, w := range words {
+		if invocationReservedWords[strings.ToLower(strings.Trim(w, ".'"))] {
+			errs = append(errs, fmt.Errorf("invocation name %q cannot contain the word %q", name, w))
+		}
+	}
+
+	if strings.ToLower(name) != name {
+		errs = append(errs, fmt.Errorf("invocation name %q must be lower case", name))
+	}
+	for _, r := range name {
+		if unicode.IsDigit(r) {
+			errs = append(errs, fmt.Errorf("invocation name %q must spell out numbers", name))
+			break
+		}
+		if !unicode.IsLetter(r) && r != ' ' && r != '\'' && r != '.' {
+			errs = append(errs, fmt.Errorf("invocation name %q contains illegal character %q", name, r))
+			break
+		}
+	}
+
+	// Periods are only allowed in initialisms such as "n. f. l."
+	for _, w := range words {
+		if strings.Contains(w, ".") && !(len([]rune(w)) == 2 && strings.HasSuffix(w, ".")) {
+			errs = append(errs, fmt.Errorf("invocation name %q may only use periods in initialisms like \"a. b. c.\"", name))
+			break
+		}
+	}
+
+	return errs
+}
+
+// SampleSlots returns the names of the slots referenced by a sample utterance, in order.
+// An error is returned if the slot references are malformed.
+func SampleSlots(sample string) ([]string, error) {
+	var slots []string
+
+	rest := sample
+	for {
+		open := strings.IndexAny(rest, "{}")
+		if open < 0 {
+			break
+		}
+		if rest[open] == '}' {
+			return nil, fmt.Errorf("sample %q has an unmatched '}'", sample)
+		}
+		end := strings.IndexAny(rest[open+1:], "{}")
+		if end < 0 || rest[open+1+end] == '{' {
+			return nil, fmt.Errorf("sample %q has an unmatched '{'", sample)
+		}
+		name := rest[open+1 : open+1+end]
+		if name == "" || strings.ContainsAny(name, " \t") {
+			return nil, fmt.Errorf("sample %q has an invalid slot reference {%s}", sample, name)
+		}
+		slots = append(slots, name)
+
+		rest = rest[open+1+end+1:]
+		if strings.HasPrefix(rest, "{") {
+			return nil, fmt.Errorf("sample %q has adjacent slots without a space between them", sample)
+		}
+	}
+
+	return slots, nil
+}
+
+// sampleIllegalRune reports the first character of a sample utterance (outside of slot
+// references) that Alexa does not accept, or 0 if there is none.
+func sampleIllegalRune(sample string) rune {
+	inSlot := false
+	for _, r := range sample {
+		switch {
+		case r == '{':
+			inSlot = true
+		case r == '}':
+			inSlot = false
+		case inSlot:
+			continue
+		case unicode.IsLetter(r) || r == ' ' || r == '\'' || r == '.' || r == '-':
+			continue
+		default:
+			return r
+		}
+	}
+	return 0
+}
+
+// ValidateSample checks the syntax of a sample utterance against the slots of its intent
+func ValidateSample(sample string, slots []Slot) error {
+	if strings.TrimSpace(sample) == "" {
+		return errors.New("sample is empty")
+	}
+
+	names, err := SampleSlots(sample)
+	if err != nil {
+		return err
+	}
+	if r := sampleIllegalRune(sample); r != 0 {
+		if unicode.IsDigit(r) {
+			return fmt.Errorf("sample %q must spell out numbers", sample)
+		}
+		return fmt.Errorf("sample %q contains illegal character %q", sample, r)
+	}
+
+	seen := map[string]bool{}
+	for _, name := range names {
+		found := false
+		for _, s := range slots {
+			if s.Name == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("sample %q references undefined slot {%s}", sample, name)
+		}
+		if seen[name] {
+			return fmt.Errorf("sample %q references slot {%s} more than once", sample, name)
+		}
+		seen[name] = true
+	}
+
+	return nil
+}
+
+// Validate checks the language model for invalid invocation names, sample utterances
+// and slot types, returning every problem found.
+func (m *LanguageModel) Validate() []error {
+	errs := ValidateInvocationName(m.InvocationName)
+
+	intents := map[string]bool{}
+	for _, intent := range m.Intents {
+		if intents[intent.Name] {
+			errs = append(errs, fmt.Errorf("intent %s is defined more than once", intent.Name))
+		}
+		intents[intent.Name] = true
+		if strings.HasPrefix(intent.Name, "AMAZON.") && !alexa.IsBuiltInIntent(intent.Name) {
+			errs = append(errs, fmt.Errorf("intent %s is not an Amazon built-in intent", intent.Name))
+		}
+
+		for _, s := range intent.Slots {
+			if err := m.checkSlotType(intent.Name, s); err != nil {
+				errs = append(errs, err)
+			}
+		}
+
+		samples := map[string]bool{}
+		for _, sample := range intent.Samples {
+			if err := ValidateSample(sample, intent.Slots); err != nil {
+				errs = append(errs, fmt.Errorf("intent %s: %v", intent.Name, err))
+			}
+			key := strings.ToLower(sample)
+			if samples[key] {
+				errs = append(errs, fmt.Errorf("intent %s: sample %q is repeated", intent.Name, sample))
+			}
+			samples[key] = true
+		}
+	}
+
+	return errs
+}
+
+// checkSlotType reports a slot whose type is neither defined by the model nor an Amazon
+// built-in slot type
+func (m *LanguageModel) checkSlotType(intent string, s Slot) error {
+	if strings.HasPrefix(s.Type, "AMAZON.") {
+		if !alexa.IsBuiltInSlotType(s.Type) {
+			return fmt.Errorf("intent %s slot %s has unknown built-in type %s", intent, s.Name, s.Type)
+		}
+		return nil
+	}
+	if m.Type(s.Type) == nil {
+		return fmt.Errorf("intent %s slot %s has undefined type %s", intent, s.Name, s.Type)
+	}
+	return nil
+}
+
+// ValidateLocale checks that the built-in intents and slot types used by the language
+// model are available in the locale, returning one error per built-in that is not.
+func (m *LanguageModel) ValidateLocale(locale string) []error {
+	var errs []error
+	for _, intent := range m.Intents {
+		if b, ok := alexa.BuiltInIntents[intent.Name]; ok && !b.Supports(locale) {
+			errs = append(errs, fmt.Errorf("intent %s is not available in %s", intent.Name, locale))
+		}
+		for _, s := range intent.Slots {
+			if b, ok := alexa.BuiltInSlotTypes[s.Type]; ok && !b.Supports(locale) {
+				errs = append(errs, fmt.Errorf("intent %s slot %s: type %s is not available in %s", intent.Name, s.Name, s.Type, locale))
+			}
+		}
+	}
+	return errs
+}
@@ -0,0 +1,354 @@
+package askgo
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/spirilis/askgo/alexa"
+	"github.com/spirilis/askgo/i18n"
+)
+
+// ListSessionKeyPrefix starts the session attribute holding the cursor of a ListPager,
+// followed by its name
+const ListSessionKeyPrefix = "askgo.list."
+
+// DefaultPageSize is the number of items read per page when the pager does not set its own
+const DefaultPageSize = 3
+
+// Default prompts of ListPager, used when More or End are not set
+const (
+	DefaultListMore = "Would you like to hear more?"
+	DefaultListEnd  = "That's the end of the list."
+)
+
+// DefaultListDocument is a plain APL list for ListPager.APL, showing the title and the
+// items of the page from the listData datasource
+var DefaultListDocument = json.RawMessage(`{
+	"type": "APL",
+	"version": "1.8",
+	"mainTemplate": {
+		"parameters": ["listData"],
+		"items": [{
+			"type": "Container",
+			"width": "100vw",
+			"height": "100vh",
+			"paddingLeft": "5vw",
+			"paddingRight": "5vw",
+			"paddingTop": "4vh",
+			"items": [
+				{"type": "Text", "text": "${listData.title}", "fontSize": "40dp", "fontWeight": "bold"},
+				{
+					"type": "Sequence",
+					"id": "listItems",
+					"grow": 1,
+					"data": "${listData.items}",
+					"items": [{"type": "Text", "id": "${data.token}", "text": "${data.primaryText}", "fontSize": "32dp", "paddingTop": "2vh"}]
+				}
+			]
+		}]
+	}
+}`)
+
+// ListPage is the page of a list read in one turn
+type ListPage[T any] struct {
+	Items []T
+	// Number is the page number, starting at 1, out of Pages
+	Number int
+	Pages  int
+	// Offset is the index of the first item of the page in the whole list of Total items
+	Offset int
+	Total  int
+}
+
+// First reports whether this is the first page
+func (p ListPage[T]) First() bool {
+	return p.Number <= 1
+}
+
+// Last reports whether this is the last page
+func (p ListPage[T]) Last() bool {
+	return p.Number >= p.Pages
+}
+
+// ListPager reads a list a page at a time, "here are the first three... would you like
+// to hear more?".  Start reads the first page and keeps the list and the cursor in the
+// session attributes, after which the pager handles AMAZON.NextIntent, PreviousIntent,
+// RepeatIntent, StartOverIntent and YesIntent.  Register it as a request interceptor too,
+// so the list is forgotten when the user says anything else:
+//
+//	var results = &askgo.ListPager[recipe]{
+//		Name:  "recipes",
+//		Title: "Recipes",
+//		Item:  func(input askgo.HandlerInput, r recipe) string { return r.Name },
+//	}
+//	skill.RequestInterceptors = append(skill.RequestInterceptors, results)
+//	skill.Handlers = append([]askgo.RequestHandler{results}, skill.Handlers...)
+//
+//	// in the search handler
+//	return results.Start(input, found)
+//
+// The last page ends the list: the cursor is cleared and the response ends the session,
+// which the caller of Start may still keep open with a question of its own.  The items are
+// stored as JSON, so keep them small: session attributes count towards the size limit of
+// the response.
+type ListPager[T any] struct {
+	// Name keeps the session attributes of several lists apart
+	Name string
+	// PageSize is the number of items per page, 0 uses DefaultPageSize
+	PageSize int
+	// Title of the list on display devices
+	Title string
+	// APL, when set, is the APL document shown on devices supporting APL, bound to a
+	// listData datasource with the title, page number and items of the page.
+	// DefaultListDocument is a plain list.  Devices with only the Display interface
+	// get a ListTemplate1.
+	APL interface{}
+
+	// Item returns the text of an item, spoken and displayed.  Without it items are
+	// formatted with fmt.Sprint.
+	Item func(input HandlerInput, item T) string
+	// Format returns the speech of a page.  Without it the items are joined as a list in
+	// the locale of the request, followed by More or End.
+	Format func(input HandlerInput, page ListPage[T]) string
+	// More is asked after a page when more follow and End is said after the last one.
+	// Both go through T, so they may be message catalog keys.  More is also the reprompt.
+	More string
+	End  string
+}
+
+var _ RequestHandler = &ListPager[string]{}
+var _ RequestInterceptor = &ListPager[string]{}
+
+// listCursor is the list and the page being read, stored in the session attributes
+type listCursor struct {
+	Items json.RawMessage `json:"items"`
+	Page  int             `json:"page"`
+}
+
+func (p *ListPager[T]) sessionKey() string {
+	return ListSessionKeyPrefix + p.Name
+}
+
+func (p *ListPager[T]) pageSize() int {
+	if p.PageSize <= 0 {
+		return DefaultPageSize
+	}
+	return p.PageSize
+}
+
+func (p *ListPager[T]) load(input HandlerInput) (*listCursor, []T, bool) {
+	stored, ok := input.GetRequestEnvelope().Session.Attributes[p.sessionKey()].(string)
+	if !ok {
+		return nil, nil, false
+	}
+	cursor := &listCursor{}
+	var items []T
+	err := json.Unmarshal([]byte(stored), cursor)
+	if err == nil {
+		err = json.Unmarshal(cursor.Items, &items)
+	}
+	if err != nil {
+		log.Printf("ListPager %s: discarding unreadable cursor: %v", p.Name, err)
+		return nil, nil, false
+	}
+	return cursor, items, true
+}
+
+func (p *ListPager[T]) save(input HandlerInput, cursor *listCursor) error {
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return err
+	}
+	SessionAttributes(input)[p.sessionKey()] = string(data)
+	return nil
+}
+
+// Active reports whether a list is being read
+func (p *ListPager[T]) Active(input HandlerInput) bool {
+	_, _, ok := p.load(input)
+	return ok
+}
+
+// Cancel forgets the list being read
+func (p *ListPager[T]) Cancel(input HandlerInput) {
+	delete(SessionAttributes(input), p.sessionKey())
+}
+
+// Start keeps the items in the session attributes and reads the first page.  A list that
+// fits on one page ends right away.
+func (p *ListPager[T]) Start(input HandlerInput, items []T) (*ResponseEnvelope, error) {
+	data, err := json.Marshal(items)
+	if err != nil {
+		return nil, fmt.Errorf("ListPager %s: %w", p.Name, err)
+	}
+	cursor := &listCursor{Items: data}
+	if err := p.save(input, cursor); err != nil {
+		return nil, err
+	}
+	return p.render(input, items, cursor.Page), nil
+}
+
+// Process forgets the list when the request is not one of the navigation intents
+func (p *ListPager[T]) Process(input HandlerInput) error {
+	if p.Active(input) && !p.CanHandle(input) {
+		p.Cancel(input)
+	}
+	return nil
+}
+
+// CanHandle accepts the navigation intents while a list is being read
+func (p *ListPager[T]) CanHandle(input HandlerInput) bool {
+	request := input.GetRequest()
+	if request.Type != "IntentRequest" || !p.Active(input) {
+		return false
+	}
+	switch request.Intent.Name {
+	case alexa.NextIntent, alexa.PreviousIntent, alexa.RepeatIntent, alexa.StartOverIntent, alexa.YesIntent:
+		return true
+	}
+	return false
+}
+
+// Handle moves the cursor and reads the page.  Previous on the first page reads it again.
+func (p *ListPager[T]) Handle(input HandlerInput) (*ResponseEnvelope, error) {
+	cursor, items, _ := p.load(input)
+	if cursor == nil {
+		return nil, fmt.Errorf("ListPager %s has no list to read", p.Name)
+	}
+	last := p.page(items, cursor.Page).Pages - 1
+
+	switch input.GetRequest().Intent.Name {
+	case alexa.NextIntent, alexa.YesIntent:
+		if cursor.Page < last {
+			cursor.Page++
+		}
+	case alexa.PreviousIntent:
+		if cursor.Page > 0 {
+			cursor.Page--
+		}
+	case alexa.StartOverIntent:
+		cursor.Page = 0
+	}
+
+	if err := p.save(input, cursor); err != nil {
+		return nil, err
+	}
+	return p.render(input, items, cursor.Page), nil
+}
+
+// page returns the page at index n, counted from 0
+func (p *ListPager[T]) page(items []T, n int) ListPage[T] {
+	size := p.pageSize()
+	pages := (len(items) + size - 1) / size
+	if pages == 0 {
+		pages = 1
+	}
+	if n >= pages {
+		n = pages - 1
+	}
+	start := n * size
+	end := start + size
+	if end > len(items) {
+		end = len(items)
+	}
+	return ListPage[T]{Items: items[start:end], Number: n + 1, Pages: pages, Offset: start, Total: len(items)}
+}
+
+func (p *ListPager[T]) text(input HandlerInput, key, def string) string {
+	if key == "" {
+		return def
+	}
+	return i18n.FromContext(input.GetContext()).T(key)
+}
+
+func (p *ListPager[T]) itemText(input HandlerInput, item T) string {
+	if p.Item == nil {
+		return fmt.Sprint(item)
+	}
+	return p.Item(input, item)
+}
+
+// render speaks the page at index n, and shows it on display devices.  The last page
+// cancels the list.
+func (p *ListPager[T]) render(input HandlerInput, items []T, n int) *ResponseEnvelope {
+	page := p.page(items, n)
+	prompt := p.text(input, p.More, DefaultListMore)
+	if page.Last() {
+		prompt = p.text(input, p.End, DefaultListEnd)
+	}
+
+	var speech string
+	if p.Format != nil {
+		speech = p.Format(input, page)
+	} else {
+		texts := make([]string, len(page.Items))
+		for i, item := range page.Items {
+			texts[i] = p.itemText(input, item)
+		}
+		speech = prompt
+		if len(texts) > 0 {
+			speech = i18n.JoinList(input.GetRequest().Locale, texts) + ". " + prompt
+		}
+	}
+
+	response := input.GetResponse().Speak(speech)
+	if page.Last() {
+		p.Cancel(input)
+		response.WithShouldEndSession(true)
+	} else {
+		response.WithShouldEndSession(false).Reprompt(prompt)
+	}
+	switch {
+	case p.APL != nil && Supports(input, alexa.InterfaceAPL):
+		response.AddAPLRenderDocumentDirective(p.Name, p.APL, p.datasources(input, page))
+	case Supports(input, alexa.InterfaceDisplay):
+		response.AddRenderTemplateDirective(p.template(input, page))
+	}
+	return response
+}
+
+// itemToken identifies the item at index in the whole list on the screen
+func (p *ListPager[T]) itemToken(index int) string {
+	return p.Name + "-" + strconv.Itoa(index)
+}
+
+// datasources returns the listData datasource of the APL document
+func (p *ListPager[T]) datasources(input HandlerInput, page ListPage[T]) map[string]interface{} {
+	items := make([]map[string]interface{}, len(page.Items))
+	for i, item := range page.Items {
+		items[i] = map[string]interface{}{
+			"token":       p.itemToken(page.Offset + i),
+			"primaryText": p.itemText(input, item),
+		}
+	}
+	return map[string]interface{}{
+		"listData": map[string]interface{}{
+			"title": p.Title,
+			"page":  page.Number,
+			"pages": page.Pages,
+			"items": items,
+		},
+	}
+}
+
+// template returns a ListTemplate1 showing the items of the page
+func (p *ListPager[T]) template(input HandlerInput, page ListPage[T]) alexa.DisplayTemplate {
+	listItems := make([]alexa.DisplayListItem, len(page.Items))
+	for i, item := range page.Items {
+		listItems[i] = alexa.DisplayListItem{
+			Token: p.itemToken(page.Offset + i),
+			TextContent: alexa.TextContent{
+				PrimaryText: alexa.DisplayTextContent{Type: "PlainText", Text: p.itemText(input, item)},
+			},
+		}
+	}
+	return alexa.DisplayTemplate{
+		Type:       "ListTemplate1",
+		Token:      p.Name,
+		BackButton: "HIDDEN",
+		Title:      p.Title,
+		ListItems:  listItems,
+	}
+}
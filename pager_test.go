@@ -0,0 +1,104 @@
+package askgo_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/spirilis/askgo"
+	"github.com/spirilis/askgo/alexa"
+	"github.com/spirilis/askgo/internal/skilltest"
+	"github.com/stretchr/testify/require"
+)
+
+type searchHandler struct {
+	pager *askgo.ListPager[string]
+}
+
+func (h *searchHandler) CanHandle(input askgo.HandlerInput) bool {
+	return input.GetRequest().Intent.Name == "SearchIntent"
+}
+
+func (h *searchHandler) Handle(input askgo.HandlerInput) (*askgo.ResponseEnvelope, error) {
+	return h.pager.Start(input, []string{"apples", "pears", "plums", "cherries", "figs"})
+}
+
+func Test_ListPager(t *testing.T) {
+	pager := &askgo.ListPager[string]{Name: "fruit", Title: "Fruit"}
+	skill := &askgo.Skill{
+		IgnoreTimestamp:     true,
+		RequestInterceptors: []askgo.RequestInterceptor{pager},
+		Handlers: []askgo.RequestHandler{
+			pager,
+			&searchHandler{pager: pager},
+			&skilltest.IntentHandler{Intent: alexa.RepeatIntent, Speech: "repeat"},
+			&skilltest.IntentHandler{Intent: "AnswerIntent", Speech: "answer"},
+		},
+	}
+
+	session := skilltest.NewSession(t, skill)
+	display, apl := false, false
+	turn := func(intent string) *askgo.ResponseEnvelope {
+		envelope := skilltest.IntentRequest("en-US", intent)
+		if display {
+			envelope.Context.System.Device.SupportedInterfaces.Display = &alexa.DisplayInterface{TemplateVersion: "1.0"}
+		}
+		if apl {
+			envelope.Context.System.Device.SupportedInterfaces.APL = &alexa.APLInterface{Runtime: alexa.APLRuntime{MaxVersion: "1.8"}}
+		}
+		return session.Process(envelope)
+	}
+	speech := func(intent string) string {
+		return turn(intent).Response.OutputSpeech.SSML
+	}
+
+	require.Nil(t, turn(alexa.NextIntent))
+
+	response := turn("SearchIntent")
+	require.Equal(t, "<speak>apples, pears, and plums. Would you like to hear more?</speak>", response.Response.OutputSpeech.SSML)
+	require.Equal(t, "<speak>Would you like to hear more?</speak>", response.Response.Reprompt.OutputSpeech.SSML)
+	require.Equal(t, "<speak>apples, pears, and plums. Would you like to hear more?</speak>", speech(alexa.PreviousIntent))
+
+	// The last page ends the list
+	response = turn(alexa.YesIntent)
+	require.Equal(t, "<speak>cherries and figs. That's the end of the list.</speak>", response.Response.OutputSpeech.SSML)
+	require.Nil(t, response.Response.Reprompt)
+	require.True(t, response.Response.ShouldSessionEnd)
+	require.NotContains(t, session.Attributes, askgo.ListSessionKeyPrefix+"fruit")
+	require.Equal(t, "<speak>repeat</speak>", speech(alexa.RepeatIntent))
+	require.Nil(t, turn(alexa.NextIntent))
+
+	// Another intent forgets the list
+	turn("SearchIntent")
+	require.Equal(t, "<speak>answer</speak>", speech("AnswerIntent"))
+	require.NotContains(t, session.Attributes, askgo.ListSessionKeyPrefix+"fruit")
+	require.Nil(t, turn(alexa.YesIntent))
+
+	display = true
+	response = turn("SearchIntent")
+	require.Equal(t, "<speak>apples, pears, and plums. Would you like to hear more?</speak>", response.Response.OutputSpeech.SSML)
+	require.Len(t, response.Response.Directives, 1)
+	directive := response.Response.Directives[0].(*alexa.DisplayRenderTemplateDirective)
+	require.Equal(t, "ListTemplate1", directive.Template.Type)
+	require.Equal(t, "Fruit", directive.Template.Title)
+	require.Len(t, directive.Template.ListItems, 3)
+	require.Equal(t, "fruit-2", directive.Template.ListItems[2].Token)
+	require.Equal(t, "plums", directive.Template.ListItems[2].TextContent.PrimaryText.Text)
+
+	pager.APL = askgo.DefaultListDocument
+	response = turn(alexa.RepeatIntent)
+	require.Len(t, response.Response.Directives, 1)
+	_, ok := response.Response.Directives[0].(*alexa.DisplayRenderTemplateDirective)
+	require.True(t, ok, "a device without APL gets the display template")
+
+	apl = true
+	response = turn(alexa.NextIntent)
+	require.Len(t, response.Response.Directives, 1)
+	_, err := json.Marshal(response)
+	require.NoError(t, err)
+	document := response.Response.Directives[0].(*alexa.APLRenderDocumentDirective)
+	require.Equal(t, "fruit", document.Token)
+	listData := document.Datasources["listData"].(map[string]interface{})
+	require.Equal(t, 2, listData["page"])
+	require.Equal(t, "figs", listData["items"].([]map[string]interface{})[1]["primaryText"])
+	require.Equal(t, "fruit-4", listData["items"].([]map[string]interface{})[1]["token"])
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/spirilis/askgo/alexa"
+	"github.com/spirilis/askgo/i18n"
 )
 
 // RequestEnvelope is really alexa.RequestEnvelope
@@ -27,6 +28,10 @@ type Skill struct {
 	// IgnoreTimestamp should be used during debugging to test with hard-coded requests
 	IgnoreTimestamp bool
 
+	// Messages is the message catalog used by T, the localizer for the
+	// request locale is made available before any interceptor or handler runs.
+	Messages *i18n.Catalog
+
 	// Request interceptors are invoked immediately prior to execution of the request handler
 	// for an incoming request. Request attributes provide a way for request interceptors to
 	// pass data and entities on to request handlers.
@@ -45,6 +50,11 @@ type Skill struct {
 	// They are invoked by the SDK when an error is returned during the
 	// course of request processing.
 	ErrorHandlers []ErrorHandler
+
+	// Locales holds additional interceptors and handlers for a locale (de-DE) or language
+	// (de), chosen from Request.Locale.  Handlers of the matching sets are tried before the
+	// handlers above, which remain the fallback for every locale.
+	Locales map[string]*HandlerSet
 }
 
 // HandlerInput is the standard type for input for request handlers,
@@ -67,6 +77,38 @@ type HandlerInput interface {
 
 	// Update the running context object
 	SetContext(ctx context.Context)
+
+}
+
+// InputHelpers is a HandlerInput with the helpers of the package as methods, implemented
+// by DefaultHandler.  HandlerInput itself keeps its original methods so that other
+// implementations keep compiling; handlers given a DefaultHandler may assert for it:
+//
+//	if in, ok := input.(askgo.InputHelpers); ok {
+//		speech = in.T("welcome")
+//	}
+type InputHelpers interface {
+	HandlerInput
+
+	// T renders the message catalog entry for key in the locale of the request
+	T(key string, params ...i18n.Params) string
+
+	// GetSessionAttributes returns the session attributes sent back with the response,
+	// initialized from the attributes of the request session.  Changes made to the map
+	// are kept for the next request of the session.
+	GetSessionAttributes() map[string]interface{}
+
+	// BindSlots fills the slot tagged fields of the struct v points to from the intent of
+	// the request, returning a *SlotError for missing and invalid slots
+	BindSlots(v interface{}) error
+
+	// AskConfirm asks a yes or no question, keeping the action and its payload in the
+	// session attributes until Confirmations routes the answer
+	AskConfirm(prompt, action string, payload interface{}) (*ResponseEnvelope, error)
+
+	// Supports reports whether the device of the request supports the interface, one of
+	// the alexa.Interface constants
+	Supports(iface string) bool
 }
 
 // RequestInterceptor are invoked immediately prior to execution of the request handler
@@ -131,36 +173,42 @@ func (skill *Skill) ProcessRequest(input HandlerInput) (interface{}, error) {
 		log.Println("Ignoring timestamp verification.")
 	}
 
-	for _, interceptor := range skill.RequestInterceptors {
+	if skill.Messages != nil {
+		input.SetContext(i18n.NewContext(input.GetContext(), skill.Messages.Localizer(envelope.Request.Locale)))
+	}
+
+	set := skill.handlerSet(envelope.Request.Locale)
+
+	for _, interceptor := range set.RequestInterceptors {
 		if err := interceptor.Process(input); err != nil {
-			return skill.dispatchError(input, err)
+			return set.dispatchError(input, err)
 		}
 	}
 
 	var response *ResponseEnvelope
 
-	for _, handler := range skill.Handlers {
+	for _, handler := range set.Handlers {
 		if handler.CanHandle(input) {
 			var err error
 			response, err = handler.Handle(input)
 			if err != nil {
-				return skill.dispatchError(input, err)
+				return set.dispatchError(input, err)
 			}
 			break
 		}
 	}
 
-	for _, interceptor := range skill.ResponseInterceptors {
+	for _, interceptor := range set.ResponseInterceptors {
 		if err := interceptor.Process(input, response); err != nil {
-			return skill.dispatchError(input, err)
+			return set.dispatchError(input, err)
 		}
 	}
 
 	return response, nil
 }
 
-func (skill *Skill) dispatchError(input HandlerInput, err error) (interface{}, error) {
-	for _, handler := range skill.ErrorHandlers {
+func (set *HandlerSet) dispatchError(input HandlerInput, err error) (interface{}, error) {
+	for _, handler := range set.ErrorHandlers {
 		if handler.CanHandle(input, err) {
 			return handler.Handle(input, err)
 		}
@@ -211,7 +259,7 @@ type DefaultHandler struct {
 	context  context.Context
 }
 
-var _ HandlerInput = &DefaultHandler{}
+var _ InputHelpers = &DefaultHandler{}
 
 // NewDefaultHandler builds a structure that supports the default HandlerInput methods
 func NewDefaultHandler(ctx context.Context, envelope *RequestEnvelope) *DefaultHandler {
@@ -236,6 +284,25 @@ func (handler *DefaultHandler) GetResponse() *ResponseEnvelope {
 	return handler.response
 }
 
+// GetSessionAttributes -- the session attributes of the response, see SessionAttributes
+func (handler *DefaultHandler) GetSessionAttributes() map[string]interface{} {
+	return SessionAttributes(handler)
+}
+
+// SessionAttributes returns the session attributes sent back with the response, starting
+// from a copy of those of the request session.  Changes made to the map are kept for the
+// next request of the session.
+func SessionAttributes(input HandlerInput) map[string]interface{} {
+	response := input.GetResponse()
+	if response.SessionAttributes == nil {
+		response.SessionAttributes = make(map[string]interface{})
+		for k, v := range input.GetRequestEnvelope().Session.Attributes {
+			response.SessionAttributes[k] = v
+		}
+	}
+	return response.SessionAttributes
+}
+
 // GetContext returns the default context from construction
 func (handler *DefaultHandler) GetContext() context.Context {
 	return handler.context
@@ -245,3 +312,37 @@ func (handler *DefaultHandler) GetContext() context.Context {
 func (handler *DefaultHandler) SetContext(ctx context.Context) {
 	handler.context = ctx
 }
+
+// T renders a message from the catalog of the skill in the locale of the request, see the
+// T function
+func (handler *DefaultHandler) T(key string, params ...i18n.Params) string {
+	return T(handler, key, params...)
+}
+
+// T renders a message from the catalog of the skill in the locale of the request,
+// without a catalog the key is returned
+func T(input HandlerInput, key string, params ...i18n.Params) string {
+	return i18n.FromContext(input.GetContext()).T(key, params...)
+}
+
+// BindSlots fills the struct v points to from the slots of the request intent, see
+// BindRequest
+func (handler *DefaultHandler) BindSlots(v interface{}) error {
+	return BindRequest(handler, v)
+}
+
+// AskConfirm asks a yes or no question for the action, see the AskConfirm function
+func (handler *DefaultHandler) AskConfirm(prompt, action string, payload interface{}) (*ResponseEnvelope, error) {
+	return AskConfirm(handler, prompt, action, payload)
+}
+
+// Supports reports whether the device supports the interface, see the Supports function
+func (handler *DefaultHandler) Supports(iface string) bool {
+	return Supports(handler, iface)
+}
+
+// Supports reports whether the device of the request supports the interface, one of the
+// alexa.Interface constants
+func Supports(input HandlerInput, iface string) bool {
+	return input.GetRequestEnvelope().Context.System.Device.SupportedInterfaces.Supports(iface)
+}
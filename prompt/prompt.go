@@ -0,0 +1,223 @@
+// Package prompt picks between alternative phrasings of a prompt so users don't hear the
+// same phrase twice in a row.
+//
+// Each named pool holds weighted alternatives.  The most recent choices of every pool are
+// remembered in the session attributes and are not picked again until NoRepeat other
+// choices have been made.
+//
+//	var prompts = prompt.New(nil)
+//
+//	func init() {
+//		prompts.Pool("correct", "Booya", "All righty", "Bam", "Bazinga").
+//			Format(func(b *ssml.Builder, text string) {
+//				b.SayAs(ssml.Interjection, text).Break(ssml.Strong)
+//			})
+//	}
+//
+//	speech := prompts.Append(input, "correct", ssml.New()).Text(question)
+package prompt
+
+import (
+	"fmt"
+	"log"
+	"math/rand"
+
+	"github.com/spirilis/askgo"
+	"github.com/spirilis/askgo/ssml"
+)
+
+// SessionKey is the session attribute holding the recent choices of every pool
+const SessionKey = "askgo.prompts"
+
+// DefaultNoRepeat is the number of recent choices avoided when a pool does not set its own
+const DefaultNoRepeat = 1
+
+// Random is the source of randomness, *rand.Rand satisfies it
+type Random interface {
+	Intn(n int) int
+}
+
+type defaultRandom struct{}
+
+func (defaultRandom) Intn(n int) int {
+	return rand.Intn(n)
+}
+
+// Alternative is one phrasing of a prompt.  Weight is relative to the other alternatives
+// of the pool, an alternative with a weight of 0 is never picked.
+type Alternative struct {
+	Text   string
+	Weight int
+}
+
+// Pool is a named set of alternatives
+type Pool struct {
+	alternatives []Alternative
+	noRepeat     int
+	format       func(b *ssml.Builder, text string)
+}
+
+// Variations holds the prompt pools of a skill
+type Variations struct {
+	random Random
+	pools  map[string]*Pool
+}
+
+// New returns an empty set of pools using the given source of randomness.  Pass nil to
+// use math/rand, or a seeded *rand.Rand for deterministic tests.
+func New(random Random) *Variations {
+	if random == nil {
+		random = defaultRandom{}
+	}
+	return &Variations{random: random, pools: map[string]*Pool{}}
+}
+
+// Pool returns the named pool, creating it if needed, and adds the alternatives to it
+// with a weight of 1
+func (v *Variations) Pool(name string, alternatives ...string) *Pool {
+	pool, ok := v.pools[name]
+	if !ok {
+		pool = &Pool{noRepeat: DefaultNoRepeat}
+		v.pools[name] = pool
+	}
+	for _, text := range alternatives {
+		pool.alternatives = append(pool.alternatives, Alternative{Text: text, Weight: 1})
+	}
+	return pool
+}
+
+// Add adds an alternative with a weight, 0 to keep it out of the picks.  It panics if the
+// weight is negative.
+func (p *Pool) Add(text string, weight int) *Pool {
+	if weight < 0 {
+		panic(fmt.Sprintf("prompt: negative weight %d for %q", weight, text))
+	}
+	p.alternatives = append(p.alternatives, Alternative{Text: text, Weight: weight})
+	return p
+}
+
+// NoRepeat sets how many of the most recent choices are not picked again, it is capped
+// at one less than the number of alternatives that can be picked.  A negative n is taken
+// as 0.
+func (p *Pool) NoRepeat(n int) *Pool {
+	if n < 0 {
+		n = 0
+	}
+	p.noRepeat = n
+	return p
+}
+
+// Format sets how a choice is added to an SSML builder, by default it is added as text
+func (p *Pool) Format(format func(b *ssml.Builder, text string)) *Pool {
+	p.format = format
+	return p
+}
+
+// recent returns the indexes recently chosen from the pool.  Session attributes come
+// back from Alexa as JSON, so numbers arrive as float64.
+func recent(attributes map[string]interface{}, name string) []int {
+	all, _ := attributes[SessionKey].(map[string]interface{})
+	var out []int
+	switch list := all[name].(type) {
+	case []int:
+		out = append(out, list...)
+	case []interface{}:
+		for _, v := range list {
+			if f, ok := v.(float64); ok {
+				out = append(out, int(f))
+			} else if i, ok := v.(int); ok {
+				out = append(out, i)
+			}
+		}
+	}
+	return out
+}
+
+func remember(attributes map[string]interface{}, name string, history []int) {
+	all, ok := attributes[SessionKey].(map[string]interface{})
+	if !ok {
+		all = map[string]interface{}{}
+		attributes[SessionKey] = all
+	}
+	all[name] = history
+}
+
+// Pick chooses an alternative from the named pool, avoiding the recent choices stored in
+// the session attributes of the input, and records the choice.  An unknown pool, or one
+// without an alternative of weight above 0, returns "".
+func (v *Variations) Pick(input askgo.HandlerInput, name string) string {
+	pool, ok := v.pools[name]
+	pickable := 0
+	if ok {
+		for _, alt := range pool.alternatives {
+			if alt.Weight > 0 {
+				pickable++
+			}
+		}
+	}
+	if pickable == 0 {
+		log.Printf("prompt: no alternatives for %q", name)
+		return ""
+	}
+
+	attributes := askgo.SessionAttributes(input)
+	history := recent(attributes, name)
+
+	noRepeat := pool.noRepeat
+	if noRepeat > pickable-1 {
+		noRepeat = pickable - 1
+	}
+	if len(history) > noRepeat {
+		history = history[len(history)-noRepeat:]
+	}
+	excluded := map[int]bool{}
+	for _, n := range history {
+		excluded[n] = true
+	}
+
+	total := 0
+	for n, alt := range pool.alternatives {
+		if !excluded[n] {
+			total += alt.Weight
+		}
+	}
+
+	choice := 0
+	r := v.random.Intn(total)
+	for n, alt := range pool.alternatives {
+		if excluded[n] {
+			continue
+		}
+		if r < alt.Weight {
+			choice = n
+			break
+		}
+		r -= alt.Weight
+	}
+
+	if noRepeat > 0 {
+		history = append(history, choice)
+		if len(history) > noRepeat {
+			history = history[len(history)-noRepeat:]
+		}
+		remember(attributes, name, history)
+	}
+
+	return pool.alternatives[choice].Text
+}
+
+// Append picks from the named pool and adds the choice to the SSML builder using the
+// format of the pool
+func (v *Variations) Append(input askgo.HandlerInput, name string, b *ssml.Builder) *ssml.Builder {
+	text := v.Pick(input, name)
+	if pool, ok := v.pools[name]; ok && pool.format != nil {
+		pool.format(b, text)
+		return b
+	}
+	return b.Text(text)
+}
+
+// Speak picks from the named pool and speaks the choice as the output speech of the response
+func (v *Variations) Speak(input askgo.HandlerInput, name string) *askgo.ResponseEnvelope {
+	return input.GetResponse().Speak(v.Append(input, name, ssml.New()).String())
+}
@@ -0,0 +1,78 @@
+package prompt_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/spirilis/askgo"
+	"github.com/spirilis/askgo/internal/skilltest"
+	"github.com/spirilis/askgo/prompt"
+	"github.com/spirilis/askgo/ssml"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NoRepeat(t *testing.T) {
+	prompts := prompt.New(rand.New(rand.NewSource(1)))
+	prompts.Pool("correct", "Booya", "Bam", "Bingo").NoRepeat(2)
+
+	session := skilltest.NewSession(t, nil)
+	var picks []string
+	for n := 0; n < 30; n++ {
+		input := session.Input(skilltest.IntentRequest("en-US", "QuizIntent"))
+		picks = append(picks, prompts.Pick(input, "correct"))
+		session.Keep(input.GetResponse())
+	}
+
+	for n := 2; n < len(picks); n++ {
+		require.NotEqual(t, picks[n], picks[n-1])
+		require.NotEqual(t, picks[n], picks[n-2])
+	}
+
+	// alternatives that are never picked don't count towards NoRepeat
+	prompts.Pool("wrong").Add("Argh", 1).Add("Darn", 0).NoRepeat(1)
+	for n := 0; n < 3; n++ {
+		input := session.Input(skilltest.IntentRequest("en-US", "QuizIntent"))
+		require.Equal(t, "Argh", prompts.Pick(input, "wrong"))
+		session.Keep(input.GetResponse())
+	}
+}
+
+func Test_NoRepeatNegative(t *testing.T) {
+	prompts := prompt.New(rand.New(rand.NewSource(1)))
+	prompts.Pool("correct", "Booya", "Bam").NoRepeat(-1)
+
+	input := skilltest.NewSession(t, nil).Input(skilltest.IntentRequest("en-US", "QuizIntent"))
+	require.Contains(t, []string{"Booya", "Bam"}, prompts.Pick(input, "correct"))
+	require.NotContains(t, askgo.SessionAttributes(input), prompt.SessionKey)
+}
+
+func Test_Weighted(t *testing.T) {
+	prompts := prompt.New(rand.New(rand.NewSource(7)))
+	prompts.Pool("wrong").Add("Argh", 1).Add("Darn", 0).Add("Oof", 8).NoRepeat(0)
+	prompts.Pool("never").Add("Nope", 0)
+
+	counts := map[string]int{}
+	input := skilltest.NewSession(t, nil).Input(skilltest.IntentRequest("en-US", "QuizIntent"))
+	for n := 0; n < 1000; n++ {
+		counts[prompts.Pick(input, "wrong")]++
+	}
+	require.True(t, counts["Oof"] > 700, "%s picked %d times", "Oof", counts["Oof"])
+	require.True(t, counts["Argh"] > 50, "%s picked %d times", "Argh", counts["Argh"])
+	require.Zero(t, counts["Darn"])
+	require.NotContains(t, askgo.SessionAttributes(input), prompt.SessionKey)
+	require.Equal(t, "", prompts.Pick(input, "never"))
+	require.Panics(t, func() { prompts.Pool("wrong").Add("Ugh", -1) })
+}
+
+func Test_Speak(t *testing.T) {
+	prompts := prompt.New(nil)
+	prompts.Pool("correct", "Bazinga").Format(func(b *ssml.Builder, text string) {
+		b.SayAs(ssml.Interjection, text).Break(ssml.Strong)
+	})
+
+	input := skilltest.NewSession(t, nil).Input(skilltest.IntentRequest("en-US", "QuizIntent"))
+	response := prompts.Speak(input, "correct")
+	require.Equal(t, `<speak><say-as interpret-as="interjection">Bazinga</say-as><break strength="strong"/></speak>`,
+		response.Response.OutputSpeech.SSML)
+	require.Equal(t, "", prompts.Pick(input, "missing"))
+}
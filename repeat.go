@@ -0,0 +1,195 @@
+package askgo
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"log"
+	"strings"
+
+	"github.com/spirilis/askgo/alexa"
+	"github.com/spirilis/askgo/ssml"
+)
+
+// RepeatSessionKey is the session attribute holding the last response for Repeat
+const RepeatSessionKey = "askgo.repeat"
+
+// repeatCompressAbove is the size in bytes above which the stored response is compressed
+const repeatCompressAbove = 1024
+
+// Repeat is an opt-in built-in that remembers the speech, reprompt and card of the last
+// response in the session attributes and answers AMAZON.RepeatIntent with them.  Register
+// it as both the first request handler and a response interceptor:
+//
+//	repeat := &askgo.Repeat{}
+//	skill.Handlers = append([]askgo.RequestHandler{repeat}, skill.Handlers...)
+//	skill.ResponseInterceptors = append(skill.ResponseInterceptors, repeat)
+//
+// The last response lives in the session attributes like any other, so it only stays
+// available to repeat while the responses keep them.  Storing a response starts its session
+// attributes from those of the request when the handler set none, see SessionAttributes,
+// and the responses of Repeat itself keep them.  Responses of a request marked with
+// SkipRepeat, or without speech, are left alone: the previous response stays available as
+// long as their handler kept the session attributes.
+type Repeat struct {
+	// Preamble returns plain text spoken before the repeated speech, such as "I said".
+	// When nil the speech is repeated as-is.
+	Preamble func(input HandlerInput) string
+}
+
+var _ RequestHandler = &Repeat{}
+var _ ResponseInterceptor = &Repeat{}
+
+type skipRepeatKey struct{}
+
+// SkipRepeat keeps the response to the request from being stored by Repeat, so
+// AMAZON.RepeatIntent keeps answering with the response before it
+func SkipRepeat(input HandlerInput) {
+	ctx := input.GetContext()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	input.SetContext(context.WithValue(ctx, skipRepeatKey{}, true))
+}
+
+func skipRepeat(input HandlerInput) bool {
+	ctx := input.GetContext()
+	if ctx == nil {
+		return false
+	}
+	skip, _ := ctx.Value(skipRepeatKey{}).(bool)
+	return skip
+}
+
+type repeatedResponse struct {
+	OutputSpeech *alexa.OutputSpeech `json:"speech,omitempty"`
+	Reprompt     *alexa.OutputSpeech `json:"reprompt,omitempty"`
+	Card         *alexa.Card         `json:"card,omitempty"`
+}
+
+func encodeRepeat(r *repeatedResponse) (string, error) {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return "", err
+	}
+	if len(data) <= repeatCompressAbove {
+		return string(data), nil
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	zw.Write(data)
+	if err := zw.Close(); err != nil {
+		return "", err
+	}
+	return "gz:" + base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+func decodeRepeat(stored string) (*repeatedResponse, error) {
+	data := []byte(stored)
+	if strings.HasPrefix(stored, "gz:") {
+		compressed, err := base64.StdEncoding.DecodeString(stored[3:])
+		if err != nil {
+			return nil, err
+		}
+		zr, err := gzip.NewReader(bytes.NewReader(compressed))
+		if err != nil {
+			return nil, err
+		}
+		if data, err = io.ReadAll(zr); err != nil {
+			return nil, err
+		}
+	}
+
+	r := new(repeatedResponse)
+	if err := json.Unmarshal(data, r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *Repeat) stored(input HandlerInput) *repeatedResponse {
+	stored, ok := input.GetRequestEnvelope().Session.Attributes[RepeatSessionKey].(string)
+	if !ok {
+		return nil
+	}
+	last, err := decodeRepeat(stored)
+	if err != nil {
+		log.Printf("Repeat: unable to decode the last response: %v", err)
+		return nil
+	}
+	return last
+}
+
+// CanHandle accepts AMAZON.RepeatIntent when there is a response to repeat
+func (r *Repeat) CanHandle(input HandlerInput) bool {
+	request := input.GetRequest()
+	return request.Type == "IntentRequest" && request.Intent.Name == alexa.RepeatIntent && r.stored(input) != nil
+}
+
+// Handle answers with the stored speech, reprompt and card
+func (r *Repeat) Handle(input HandlerInput) (*ResponseEnvelope, error) {
+	last := r.stored(input)
+	SkipRepeat(input)
+	response := input.GetResponse().WithShouldEndSession(false)
+	// keep the stored response, and the rest of the session, for the next repeat
+	SessionAttributes(input)
+
+	var preamble string
+	if r.Preamble != nil {
+		preamble = strings.TrimSpace(r.Preamble(input))
+	}
+
+	if speech := last.OutputSpeech; speech != nil {
+		switch {
+		case speech.Type == "PlainText" && preamble != "":
+			response.SpeakText(preamble + " " + speech.Text)
+		case speech.Type == "PlainText":
+			response.SpeakText(speech.Text)
+		case preamble != "":
+			response.Speak(ssml.Escape(preamble) + " " + trimOutputSpeech(speech.SSML))
+		default:
+			response.Speak(speech.SSML)
+		}
+	}
+	if last.Reprompt != nil {
+		response.getResponse().Reprompt = &alexa.Reprompt{OutputSpeech: last.Reprompt}
+	}
+	response.getResponse().Card = last.Card
+
+	return response, nil
+}
+
+// Process stores the speech, reprompt and card of the response for the next repeat.
+// Responses without speech or marked with SkipRepeat are not changed.
+func (r *Repeat) Process(input HandlerInput, response *ResponseEnvelope) error {
+	if response == nil || response.Response == nil || response.Response.ShouldSessionEnd {
+		return nil
+	}
+	if skipRepeat(input) || response.Response.OutputSpeech == nil {
+		return nil
+	}
+
+	last := &repeatedResponse{
+		OutputSpeech: response.Response.OutputSpeech,
+		Card:         response.Response.Card,
+	}
+	if response.Response.Reprompt != nil {
+		last.Reprompt = response.Response.Reprompt.OutputSpeech
+	}
+
+	stored, err := encodeRepeat(last)
+	if err != nil {
+		return err
+	}
+
+	if response.SessionAttributes == nil {
+		response.SessionAttributes = SessionAttributes(input)
+	}
+	response.SessionAttributes[RepeatSessionKey] = stored
+
+	return nil
+}
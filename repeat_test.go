@@ -0,0 +1,103 @@
+package askgo_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/spirilis/askgo"
+	"github.com/spirilis/askgo/alexa"
+	"github.com/spirilis/askgo/internal/skilltest"
+	"github.com/stretchr/testify/require"
+)
+
+type speakHandler struct {
+	speech      string
+	noRepeat    bool
+	keepSession bool
+	cardTitle   string
+}
+
+func (h *speakHandler) CanHandle(input askgo.HandlerInput) bool {
+	return input.GetRequest().Intent.Name == "QuizIntent"
+}
+
+func (h *speakHandler) Handle(input askgo.HandlerInput) (*askgo.ResponseEnvelope, error) {
+	response := input.GetResponse().Speak(h.speech).Reprompt("What is your answer?")
+	if h.cardTitle != "" {
+		response.WithSimpleCardFromSpeech(h.cardTitle)
+	}
+	if h.noRepeat {
+		askgo.SkipRepeat(input)
+	}
+	if h.keepSession {
+		askgo.SessionAttributes(input)
+	}
+	return response, nil
+}
+
+func Test_Repeat(t *testing.T) {
+	quiz := &speakHandler{speech: "What is the capital of <sub alias='Alabama'>AL</sub>?", cardTitle: "Question"}
+	repeat := &askgo.Repeat{Preamble: func(input askgo.HandlerInput) string { return "I said" }}
+	skill := &askgo.Skill{
+		IgnoreTimestamp:      true,
+		Handlers:             []askgo.RequestHandler{repeat, quiz},
+		ResponseInterceptors: []askgo.ResponseInterceptor{repeat},
+	}
+
+	session := skilltest.NewSession(t, skill)
+
+	// Nothing to repeat yet
+	require.Nil(t, session.Turn(alexa.RepeatIntent))
+
+	session.Turn("QuizIntent")
+	for n := 0; n < 2; n++ {
+		response := session.Turn(alexa.RepeatIntent)
+		require.Equal(t, "<speak>I said What is the capital of <sub alias='Alabama'>AL</sub>?</speak>", response.Response.OutputSpeech.SSML)
+		require.Equal(t, "<speak>What is your answer?</speak>", response.Response.Reprompt.OutputSpeech.SSML)
+		require.Equal(t, "What is the capital of Alabama?", response.Response.Card.Content)
+	}
+
+	quiz.speech = "Hold on."
+	quiz.noRepeat = true
+	quiz.keepSession = true
+	session.Turn("QuizIntent")
+	response := session.Turn(alexa.RepeatIntent)
+	require.Contains(t, response.Response.OutputSpeech.SSML, "capital")
+
+	// Large responses are compressed
+	quiz.speech = strings.Repeat("What is the capital of Alabama? ", 100)
+	quiz.noRepeat = false
+	session.Turn("QuizIntent")
+	require.True(t, strings.HasPrefix(session.Attributes[askgo.RepeatSessionKey].(string), "gz:"))
+	response = session.Turn(alexa.RepeatIntent)
+	require.Contains(t, response.Response.OutputSpeech.SSML, strings.TrimSpace(quiz.speech))
+}
+
+func Test_RepeatSkipped(t *testing.T) {
+	quiz := &speakHandler{speech: "Hold on.", noRepeat: true}
+	repeat := &askgo.Repeat{}
+	skill := &askgo.Skill{
+		IgnoreTimestamp:      true,
+		Handlers:             []askgo.RequestHandler{repeat, quiz},
+		ResponseInterceptors: []askgo.ResponseInterceptor{repeat},
+	}
+	attributes := func() map[string]interface{} {
+		envelope := skilltest.IntentRequest("en-US", "QuizIntent")
+		envelope.Session.Attributes = map[string]interface{}{"score": 3}
+		out, err := skill.ProcessRequest(askgo.NewDefaultHandler(context.Background(), envelope))
+		require.NoError(t, err)
+		return out.(*askgo.ResponseEnvelope).SessionAttributes
+	}
+
+	// skipped responses keep the session attributes as their handler left them
+	require.Nil(t, attributes())
+	quiz.keepSession = true
+	require.Equal(t, map[string]interface{}{"score": 3}, attributes())
+
+	// a stored response carries the attributes of the request over
+	quiz.noRepeat, quiz.keepSession = false, false
+	stored := attributes()
+	require.Equal(t, 3, stored["score"])
+	require.Contains(t, stored, askgo.RepeatSessionKey)
+}
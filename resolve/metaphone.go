@@ -0,0 +1,540 @@
+package resolve
+
+import "strings"
+
+// DoubleMetaphone returns the primary and alternate Double Metaphone codes of a word, as
+// described by Lawrence Philips.  Words that sound alike, such as "Smith" and "Schmidt",
+// share a code.  The codes are not truncated to four letters, so that longer words stay
+// apart; text of several words is encoded with Phonetic.
+func DoubleMetaphone(word string) (primary, alternate string) {
+	m := newMetaphone(word)
+	m.encode()
+	return m.primary.String(), m.alternate.String()
+}
+
+// Phonetic returns the Double Metaphone codes of each word of the text, joined by spaces
+func Phonetic(text string) (primary, alternate string) {
+	var primaries, alternates []string
+	for _, word := range strings.Fields(Normalize(text)) {
+		p, a := DoubleMetaphone(word)
+		if p == "" && a == "" {
+			continue
+		}
+		primaries = append(primaries, p)
+		alternates = append(alternates, a)
+	}
+	return strings.Join(primaries, " "), strings.Join(alternates, " ")
+}
+
+type metaphone struct {
+	word      []rune
+	length    int
+	last      int
+	slavo     bool
+	primary   strings.Builder
+	alternate strings.Builder
+}
+
+func newMetaphone(word string) *metaphone {
+	upper := strings.ToUpper(strings.TrimSpace(word))
+	m := &metaphone{length: len([]rune(upper))}
+	m.last = m.length - 1
+	// pad so that lookups past the end match the spaces of patterns such as "IER "
+	m.word = []rune(upper + "     ")
+	m.slavo = strings.ContainsAny(upper, "WK") || strings.Contains(upper, "CZ") || strings.Contains(upper, "WITZ")
+	return m
+}
+
+func (m *metaphone) add(primary, alternate string) {
+	m.primary.WriteString(primary)
+	m.alternate.WriteString(alternate)
+}
+
+func (m *metaphone) both(code string) {
+	m.add(code, code)
+}
+
+// char returns the letter at pos, or 0 out of the word
+func (m *metaphone) char(pos int) rune {
+	if pos < 0 || pos >= len(m.word) {
+		return 0
+	}
+	return m.word[pos]
+}
+
+// at reports whether one of the patterns starts at pos
+func (m *metaphone) at(pos int, patterns ...string) bool {
+	if pos < 0 {
+		return false
+	}
+	for _, pattern := range patterns {
+		p := []rune(pattern)
+		if pos+len(p) > len(m.word) {
+			continue
+		}
+		if string(m.word[pos:pos+len(p)]) == pattern {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *metaphone) vowel(pos int) bool {
+	switch m.char(pos) {
+	case 'A', 'E', 'I', 'O', 'U', 'Y':
+		return true
+	}
+	return false
+}
+
+// germanic reports whether the word starts like a Dutch, German or Scandinavian name
+func (m *metaphone) germanic() bool {
+	return m.at(0, "VAN ", "VON ", "SCH")
+}
+
+func (m *metaphone) encode() {
+	index := 0
+	if m.at(0, "GN", "KN", "PN", "WR", "PS") {
+		index++
+	}
+	if m.char(0) == 'X' {
+		m.both("S")
+		index++
+	}
+
+	for index < m.length {
+		switch m.char(index) {
+		case 'A', 'E', 'I', 'O', 'U', 'Y':
+			if index == 0 {
+				m.both("A")
+			}
+			index++
+
+		case 'B':
+			m.both("P")
+			index += m.skip(index, 'B')
+
+		case 'Ç':
+			m.both("S")
+			index++
+
+		case 'C':
+			index = m.encodeC(index)
+
+		case 'D':
+			switch {
+			case m.at(index, "DG"):
+				if m.at(index+2, "I", "E", "Y") {
+					m.both("J")
+					index += 3
+				} else {
+					m.both("TK")
+					index += 2
+				}
+			case m.at(index, "DT", "DD"):
+				m.both("T")
+				index += 2
+			default:
+				m.both("T")
+				index++
+			}
+
+		case 'F':
+			m.both("F")
+			index += m.skip(index, 'F')
+
+		case 'G':
+			index = m.encodeG(index)
+
+		case 'H':
+			if (index == 0 || m.vowel(index-1)) && m.vowel(index+1) {
+				m.both("H")
+				index += 2
+			} else {
+				index++
+			}
+
+		case 'J':
+			index = m.encodeJ(index)
+
+		case 'K':
+			m.both("K")
+			index += m.skip(index, 'K')
+
+		case 'L':
+			if m.char(index+1) == 'L' {
+				if (index == m.length-3 && m.at(index-1, "ILLO", "ILLA", "ALLE")) ||
+					((m.at(m.last-1, "AS", "OS") || m.at(m.last, "A", "O")) && m.at(index-1, "ALLE")) {
+					m.add("L", "")
+				} else {
+					m.both("L")
+				}
+				index += 2
+			} else {
+				m.both("L")
+				index++
+			}
+
+		case 'M':
+			m.both("M")
+			if (m.at(index-1, "UMB") && (index+1 == m.last || m.at(index+2, "ER"))) || m.char(index+1) == 'M' {
+				index += 2
+			} else {
+				index++
+			}
+
+		case 'N':
+			m.both("N")
+			index += m.skip(index, 'N')
+
+		case 'Ñ':
+			m.both("N")
+			index++
+
+		case 'P':
+			if m.char(index+1) == 'H' {
+				m.both("F")
+				index += 2
+			} else {
+				m.both("P")
+				if m.at(index+1, "P", "B") {
+					index += 2
+				} else {
+					index++
+				}
+			}
+
+		case 'Q':
+			m.both("K")
+			index += m.skip(index, 'Q')
+
+		case 'R':
+			if index == m.last && !m.slavo && m.at(index-2, "IE") && !m.at(index-4, "ME", "MA") {
+				m.add("", "R")
+			} else {
+				m.both("R")
+			}
+			index += m.skip(index, 'R')
+
+		case 'S':
+			index = m.encodeS(index)
+
+		case 'T':
+			switch {
+			case m.at(index, "TION"), m.at(index, "TIA", "TCH"):
+				m.both("X")
+				index += 3
+			case m.at(index, "TH", "TTH"):
+				if m.at(index+2, "OM", "AM") || m.germanic() {
+					m.both("T")
+				} else {
+					m.add("0", "T")
+				}
+				index += 2
+			default:
+				m.both("T")
+				if m.at(index+1, "T", "D") {
+					index += 2
+				} else {
+					index++
+				}
+			}
+
+		case 'V':
+			m.both("F")
+			index += m.skip(index, 'V')
+
+		case 'W':
+			index = m.encodeW(index)
+
+		case 'X':
+			if !(index == m.last && (m.at(index-3, "IAU", "EAU") || m.at(index-2, "AU", "OU"))) {
+				m.both("KS")
+			}
+			if m.at(index+1, "C", "X") {
+				index += 2
+			} else {
+				index++
+			}
+
+		case 'Z':
+			if m.char(index+1) == 'H' {
+				m.both("J")
+				index += 2
+				continue
+			}
+			if m.at(index+1, "ZO", "ZI", "ZA") || (m.slavo && index > 0 && m.char(index-1) != 'T') {
+				m.add("S", "TS")
+			} else {
+				m.both("S")
+			}
+			index += m.skip(index, 'Z')
+
+		default:
+			index++
+		}
+	}
+}
+
+// skip returns 2 when the letter at index is doubled and 1 otherwise
+func (m *metaphone) skip(index int, letter rune) int {
+	if m.char(index+1) == letter {
+		return 2
+	}
+	return 1
+}
+
+func (m *metaphone) encodeC(index int) int {
+	switch {
+	case index > 1 && !m.vowel(index-2) && m.at(index-1, "ACH") && m.char(index+2) != 'I' &&
+		(m.char(index+2) != 'E' || m.at(index-2, "BACHER", "MACHER")):
+		m.both("K")
+		return index + 2
+
+	case index == 0 && m.at(index, "CAESAR"):
+		m.both("S")
+		return index + 2
+
+	case m.at(index, "CHIA"):
+		m.both("K")
+		return index + 2
+
+	case m.at(index, "CH"):
+		switch {
+		case index > 0 && m.at(index, "CHAE"):
+			m.add("K", "X")
+		case index == 0 && (m.at(index+1, "HARAC", "HARIS") || m.at(index+1, "HOR", "HYM", "HIA", "HEM")) && !m.at(0, "CHORE"):
+			m.both("K")
+		case m.germanic() || m.at(index-2, "ORCHES", "ARCHIT", "ORCHID") || m.at(index+2, "T", "S") ||
+			((m.at(index-1, "A", "O", "U", "E") || index == 0) && m.at(index+2, "L", "R", "N", "M", "B", "H", "F", "V", "W", " ")):
+			m.both("K")
+		case index > 0:
+			if m.at(0, "MC") {
+				m.both("K")
+			} else {
+				m.add("X", "K")
+			}
+		default:
+			m.both("X")
+		}
+		return index + 2
+
+	case m.at(index, "CZ") && !m.at(index-2, "WICZ"):
+		m.add("S", "X")
+		return index + 2
+
+	case m.at(index+1, "CIA"):
+		m.both("X")
+		return index + 3
+
+	case m.at(index, "CC") && !(index == 1 && m.char(0) == 'M'):
+		if m.at(index+2, "I", "E", "H") && !m.at(index+2, "HU") {
+			if (index == 1 && m.char(0) == 'A') || m.at(index-1, "UCCEE", "UCCES") {
+				m.both("KS")
+			} else {
+				m.both("X")
+			}
+			return index + 3
+		}
+		m.both("K")
+		return index + 2
+
+	case m.at(index, "CK", "CG", "CQ"):
+		m.both("K")
+		return index + 2
+
+	case m.at(index, "CI", "CE", "CY"):
+		if m.at(index, "CIO", "CIE", "CIA") {
+			m.add("S", "X")
+		} else {
+			m.both("S")
+		}
+		return index + 2
+	}
+
+	m.both("K")
+	switch {
+	case m.at(index+1, " C", " Q", " G"):
+		return index + 3
+	case m.at(index+1, "C", "K", "Q") && !m.at(index+1, "CE", "CI"):
+		return index + 2
+	}
+	return index + 1
+}
+
+func (m *metaphone) encodeG(index int) int {
+	next := m.char(index + 1)
+
+	if next == 'H' {
+		switch {
+		case index > 0 && !m.vowel(index-1):
+			m.both("K")
+		case index == 0:
+			if m.char(index+2) == 'I' {
+				m.both("J")
+			} else {
+				m.both("K")
+			}
+		case m.at(index-2, "B", "H", "D") || m.at(index-3, "B", "H", "D") || m.at(index-4, "B", "H"):
+			// silent, as in "bough" and "hugh"
+		default:
+			if index > 2 && m.char(index-1) == 'U' && m.at(index-3, "C", "G", "L", "R", "T") {
+				m.both("F")
+			} else if index > 0 && m.char(index-1) != 'I' {
+				m.both("K")
+			}
+		}
+		return index + 2
+	}
+
+	if next == 'N' {
+		switch {
+		case index == 1 && m.vowel(0) && !m.slavo:
+			m.add("KN", "N")
+		case !m.at(index+2, "EY") && m.char(index+1) != 'Y' && !m.slavo:
+			m.add("N", "KN")
+		default:
+			m.both("KN")
+		}
+		return index + 2
+	}
+
+	if m.at(index+1, "LI") && !m.slavo {
+		m.add("KL", "L")
+		return index + 2
+	}
+
+	if index == 0 && (next == 'Y' || m.at(index+1, "ES", "EP", "EB", "EL", "EY", "IB", "IL", "IN", "IE", "EI", "ER")) {
+		m.add("K", "J")
+		return index + 2
+	}
+
+	if (m.at(index+1, "ER") || next == 'Y') && !m.at(0, "DANGER", "RANGER", "MANGER") &&
+		!m.at(index-1, "E", "I") && !m.at(index-1, "RGY", "OGY") {
+		m.add("K", "J")
+		return index + 2
+	}
+
+	if m.at(index+1, "E", "I", "Y") || m.at(index-1, "AGGI", "OGGI") {
+		switch {
+		case m.germanic() || m.at(index+1, "ET"):
+			m.both("K")
+		case m.at(index+1, "IER "):
+			m.both("J")
+		default:
+			m.add("J", "K")
+		}
+		return index + 2
+	}
+
+	m.both("K")
+	return index + m.skip(index, 'G')
+}
+
+func (m *metaphone) encodeJ(index int) int {
+	if m.at(index, "JOSE") || m.at(0, "SAN ") {
+		if (index == 0 && m.char(index+4) == ' ') || m.at(0, "SAN ") {
+			m.both("H")
+		} else {
+			m.add("J", "H")
+		}
+		return index + 1
+	}
+
+	switch {
+	case index == 0:
+		m.add("J", "A")
+	case m.vowel(index-1) && !m.slavo && (m.char(index+1) == 'A' || m.char(index+1) == 'O'):
+		m.add("J", "H")
+	case index == m.last:
+		m.add("J", "")
+	case !m.at(index+1, "L", "T", "K", "S", "N", "M", "B", "Z") && !m.at(index-1, "S", "K", "L"):
+		m.both("J")
+	}
+	return index + m.skip(index, 'J')
+}
+
+func (m *metaphone) encodeS(index int) int {
+	switch {
+	case m.at(index-1, "ISL", "YSL"):
+		return index + 1
+
+	case index == 0 && m.at(index, "SUGAR"):
+		m.add("X", "S")
+		return index + 1
+
+	case m.at(index, "SH"):
+		if m.at(index+1, "HEIM", "HOEK", "HOLM", "HOLZ") {
+			m.both("S")
+		} else {
+			m.both("X")
+		}
+		return index + 2
+
+	case m.at(index, "SIO", "SIA"):
+		if m.slavo {
+			m.both("S")
+		} else {
+			m.add("S", "X")
+		}
+		return index + 3
+
+	case (index == 0 && m.at(index+1, "M", "N", "L", "W")) || m.at(index+1, "Z"):
+		m.add("S", "X")
+		return index + m.skip(index, 'Z')
+
+	case m.at(index, "SC"):
+		if m.char(index+2) == 'H' {
+			if m.at(index+3, "OO", "ER", "EN", "UY", "ED", "EM") {
+				if m.at(index+3, "ER", "EN") {
+					m.add("X", "SK")
+				} else {
+					m.both("SK")
+				}
+			} else if index == 0 && !m.vowel(3) && m.char(3) != 'W' {
+				m.add("X", "S")
+			} else {
+				m.both("X")
+			}
+		} else if m.at(index+2, "I", "E", "Y") {
+			m.both("S")
+		} else {
+			m.both("SK")
+		}
+		return index + 3
+	}
+
+	if index == m.last && m.at(index-2, "AI", "OI") {
+		m.add("", "S")
+	} else {
+		m.both("S")
+	}
+	if m.at(index+1, "S", "Z") {
+		return index + 2
+	}
+	return index + 1
+}
+
+func (m *metaphone) encodeW(index int) int {
+	if m.at(index, "WR") {
+		m.both("R")
+		return index + 2
+	}
+
+	if index == 0 && (m.vowel(index+1) || m.at(index, "WH")) {
+		if m.vowel(index + 1) {
+			m.add("A", "F")
+		} else {
+			m.both("A")
+		}
+	}
+
+	switch {
+	case (index == m.last && m.vowel(index-1)) || m.at(index-1, "EWSKI", "EWSKY", "OWSKI", "OWSKY") || m.at(0, "SCH"):
+		m.add("", "F")
+	case m.at(index, "WICZ", "WITZ"):
+		m.add("TS", "FX")
+		return index + 4
+	}
+	return index + 1
+}
@@ -0,0 +1,296 @@
+// Package resolve matches free-form slot text against the values of a custom slot type
+// locally, for when Alexa's entity resolution puts the value in the wrong slot or does
+// not match it at all.
+//
+// A Resolver scores every value, and its synonyms, against the text after normalizing
+// both, by edit distance and by Double Metaphone codes so that words that sound alike
+// match.  Abbreviations and numbers are only matched exactly, as a letter or digit apart
+// is a different value:
+//
+//	colors := resolve.FromSlotType(slotType)
+//	if best, ok := colors.ResolveSlot(request.Intent.Slots["Color"]); ok {
+//		log.Printf("matched %s (%s) with score %.2f", best.ID, best.Name, best.Score)
+//	}
+package resolve
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/spirilis/askgo/alexa"
+	"github.com/spirilis/askgo/model"
+)
+
+// DefaultThreshold is the lowest score a candidate needs when the resolver does not set
+// its own
+const DefaultThreshold = 0.7
+
+// MinFuzzyLength is the shortest text matched by edit distance or sound, shorter texts
+// such as abbreviations only match exactly
+const MinFuzzyLength = 4
+
+// Scores of the matching methods that do not depend on the distance between the texts
+const (
+	ExactScore      = 1.0
+	ResolutionScore = 1.0
+	PhoneticScore   = 0.9
+)
+
+// Methods a candidate was matched by
+const (
+	MatchExact        = "exact"
+	MatchResolution   = "resolution"
+	MatchEditDistance = "edit-distance"
+	MatchPhonetic     = "phonetic"
+)
+
+// Value is a slot type value with its ID and synonyms
+type Value struct {
+	ID       string
+	Name     string
+	Synonyms []string
+}
+
+// Candidate is a value matching the text, with a score between 0 and 1
+type Candidate struct {
+	ID   string
+	Name string
+	// Matched is the name or synonym that matched the text
+	Matched string
+	Score   float64
+	Method  string
+}
+
+// Resolver matches text against the values of a slot type
+type Resolver struct {
+	// Threshold is the lowest score returned, 0 uses DefaultThreshold
+	Threshold float64
+
+	values []Value
+	forms  [][]form
+}
+
+// form is a name or synonym prepared for matching
+type form struct {
+	text       string
+	normalized string
+	primary    string
+	alternate  string
+	exactOnly  bool
+}
+
+// New returns a resolver for the values
+func New(values ...Value) *Resolver {
+	r := &Resolver{}
+	for _, v := range values {
+		r.Add(v.ID, v.Name, v.Synonyms...)
+	}
+	return r
+}
+
+// FromSlotType returns a resolver for the values of a custom slot type of the interaction
+// model
+func FromSlotType(slotType model.SlotType) *Resolver {
+	r := &Resolver{}
+	for _, v := range slotType.Values {
+		r.Add(v.ID, v.Name.Value, v.Name.Synonyms...)
+	}
+	return r
+}
+
+// Add adds a value with its ID and synonyms
+func (r *Resolver) Add(id, name string, synonyms ...string) *Resolver {
+	r.values = append(r.values, Value{ID: id, Name: name, Synonyms: synonyms})
+
+	var forms []form
+	for _, text := range append([]string{name}, synonyms...) {
+		primary, alternate := Phonetic(text)
+		normalized := Normalize(text)
+		forms = append(forms, form{
+			text:       text,
+			normalized: normalized,
+			primary:    primary,
+			alternate:  alternate,
+			exactOnly:  exactOnly(normalized),
+		})
+	}
+	r.forms = append(r.forms, forms)
+	return r
+}
+
+// Values returns the values of the resolver
+func (r *Resolver) Values() []Value {
+	return r.values
+}
+
+func (r *Resolver) threshold() float64 {
+	if r.Threshold <= 0 {
+		return DefaultThreshold
+	}
+	return r.Threshold
+}
+
+// Resolve returns the values matching the text, best first, each with the score of its
+// best matching name or synonym.  Values scoring under the threshold are left out.
+func (r *Resolver) Resolve(text string) []Candidate {
+	normalized := Normalize(text)
+	if normalized == "" {
+		return nil
+	}
+	primary, alternate := Phonetic(normalized)
+
+	var candidates []Candidate
+	for i, value := range r.values {
+		best := Candidate{}
+		for _, f := range r.forms[i] {
+			score, method := match(normalized, primary, alternate, f)
+			if score > best.Score {
+				best = Candidate{ID: value.ID, Name: value.Name, Matched: f.text, Score: score, Method: method}
+			}
+		}
+		if best.Score >= r.threshold() {
+			candidates = append(candidates, best)
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Score > candidates[j].Score
+	})
+	return candidates
+}
+
+// Best returns the best value matching the text
+func (r *Resolver) Best(text string) (Candidate, bool) {
+	candidates := r.Resolve(text)
+	if len(candidates) == 0 {
+		return Candidate{}, false
+	}
+	return candidates[0], true
+}
+
+// ResolveSlot returns the best value for a slot.  A value Alexa resolved to one of the
+// values of the resolver is taken as is, otherwise the spoken values of the slot are
+// matched locally.
+func (r *Resolver) ResolveSlot(slot alexa.IntentSlot) (Candidate, bool) {
+	for _, match := range slot.AllMatches() {
+		if c, ok := r.lookup(match); ok {
+			return c, true
+		}
+	}
+
+	var best Candidate
+	for _, v := range slot.Values() {
+		if c, ok := r.Best(v.Value); ok && c.Score > best.Score {
+			best = c
+		}
+	}
+	return best, best.Score > 0
+}
+
+// ResolveIntent returns the best value found in any slot of the intent, for answers
+// that Alexa may put in the wrong slot
+func (r *Resolver) ResolveIntent(intent alexa.Intent) (Candidate, bool) {
+	var best Candidate
+	for _, slot := range intent.Slots {
+		if c, ok := r.ResolveSlot(slot); ok && c.Score > best.Score {
+			best = c
+		}
+	}
+	return best, best.Score > 0
+}
+
+// lookup finds the value Alexa resolved a slot to
+func (r *Resolver) lookup(resolved alexa.ResolvedValue) (Candidate, bool) {
+	for _, value := range r.values {
+		if (resolved.ID != "" && value.ID == resolved.ID) || (resolved.ID == "" && value.Name == resolved.Name) {
+			return Candidate{ID: value.ID, Name: value.Name, Matched: resolved.Name, Score: ResolutionScore, Method: MatchResolution}, true
+		}
+	}
+	return Candidate{}, false
+}
+
+// exactOnly reports whether normalized text is an abbreviation or holds a number, which
+// are not matched by edit distance or sound
+func exactOnly(normalized string) bool {
+	return len([]rune(normalized)) < MinFuzzyLength || strings.IndexFunc(normalized, unicode.IsDigit) >= 0
+}
+
+// match scores normalized text and its phonetic codes against a form
+func match(normalized, primary, alternate string, f form) (float64, string) {
+	if normalized == f.normalized {
+		return ExactScore, MatchExact
+	}
+	if f.exactOnly || exactOnly(normalized) {
+		return 0, ""
+	}
+
+	score, method := Similarity(normalized, f.normalized), MatchEditDistance
+	if primary != "" && (primary == f.primary || primary == f.alternate || alternate == f.primary || alternate == f.alternate) {
+		if PhoneticScore > score {
+			score, method = PhoneticScore, MatchPhonetic
+		}
+	}
+	return score, method
+}
+
+// Similarity returns 1 minus the edit distance between the normalized texts divided by
+// the length of the longer one: 1 for equal texts and 0 for texts with nothing in common
+func Similarity(a, b string) float64 {
+	a, b = Normalize(a), Normalize(b)
+	longest := len([]rune(a))
+	if n := len([]rune(b)); n > longest {
+		longest = n
+	}
+	if longest == 0 {
+		return 1
+	}
+	return 1 - float64(Distance(a, b))/float64(longest)
+}
+
+// Distance returns the Levenshtein edit distance between two strings, the number of
+// letters to insert, delete or replace to turn one into the other
+func Distance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(rb)]
+}
+
+var accents = strings.NewReplacer(
+	"à", "a", "á", "a", "â", "a", "ã", "a", "ä", "a", "å", "a",
+	"ç", "c", "è", "e", "é", "e", "ê", "e", "ë", "e",
+	"ì", "i", "í", "i", "î", "i", "ï", "i", "ñ", "n",
+	"ò", "o", "ó", "o", "ô", "o", "õ", "o", "ö", "o",
+	"ù", "u", "ú", "u", "û", "u", "ü", "u", "ý", "y", "ÿ", "y",
+	"ß", "ss", "&", " and ",
+)
+
+// Normalize lower cases the text, removes accents and punctuation and collapses spaces
+func Normalize(text string) string {
+	text = accents.Replace(strings.ToLower(text))
+	text = strings.Map(func(r rune) rune {
+		switch {
+		case unicode.IsLetter(r), unicode.IsDigit(r):
+			return r
+		case r == '\'':
+			return -1
+		}
+		return ' '
+	}, text)
+	return strings.Join(strings.Fields(text), " ")
+}
@@ -0,0 +1,128 @@
+package resolve_test
+
+import (
+	"testing"
+
+	"github.com/spirilis/askgo/alexa"
+	"github.com/spirilis/askgo/model"
+	"github.com/spirilis/askgo/resolve"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DoubleMetaphone(t *testing.T) {
+	for word, codes := range map[string][2]string{
+		"Smith":    {"SM0", "XMT"},
+		"Schmidt":  {"XMT", "SMT"},
+		"laugh":    {"LF", "LF"},
+		"knight":   {"NT", "NT"},
+		"Caesar":   {"SSR", "SSR"},
+		"focaccia": {"FKX", "FKX"},
+		"Jose":     {"HS", "HS"},
+		"Xavier":   {"SF", "SFR"},
+		"Wright":   {"RT", "RT"},
+		"Cabrillo": {"KPRL", "KPR"},
+		"edge":     {"AJ", "AJ"},
+		"Zhao":     {"J", "J"},
+	} {
+		primary, alternate := resolve.DoubleMetaphone(word)
+		require.Equal(t, codes, [2]string{primary, alternate}, word)
+	}
+
+	primary, _ := resolve.Phonetic("Nancy Smith")
+	require.Equal(t, "NNS SM0", primary)
+}
+
+func Test_Distance(t *testing.T) {
+	require.Equal(t, 3, resolve.Distance("kitten", "sitting"))
+	require.Equal(t, 0, resolve.Distance("", ""))
+	require.Equal(t, 4, resolve.Distance("", "café"))
+	require.Equal(t, 1.0, resolve.Similarity("Café!", "cafe"))
+	require.Equal(t, "rock and roll", resolve.Normalize("  Rock & Roll "))
+	require.Equal(t, "dont stop", resolve.Normalize("Don't  stop."))
+}
+
+func Test_Resolver(t *testing.T) {
+	states := resolve.FromSlotType(model.SlotType{Name: "US_STATE", Values: []model.SlotTypeValue{
+		{ID: "WA", Name: model.SlotTypeValueName{Value: "Washington", Synonyms: []string{"evergreen state"}}},
+		{ID: "MA", Name: model.SlotTypeValueName{Value: "Massachusetts"}},
+		{ID: "MS", Name: model.SlotTypeValueName{Value: "Mississippi"}},
+	}})
+
+	best, ok := states.Best("washington")
+	require.True(t, ok)
+	require.Equal(t, resolve.Candidate{ID: "WA", Name: "Washington", Matched: "Washington", Score: 1, Method: resolve.MatchExact}, best)
+
+	best, ok = states.Best("the evergreen state")
+	require.True(t, ok)
+	require.Equal(t, "WA", best.ID)
+	require.Equal(t, resolve.MatchEditDistance, best.Method)
+
+	best, ok = states.Best("masachusets")
+	require.True(t, ok)
+	require.Equal(t, "MA", best.ID)
+	require.Equal(t, resolve.MatchPhonetic, best.Method)
+
+	_, ok = states.Best("texas")
+	require.False(t, ok)
+	require.Empty(t, states.Resolve(""))
+
+	states.Threshold = 0.2
+	candidates := states.Resolve("missouri")
+	require.True(t, len(candidates) > 1)
+	require.Equal(t, "MS", candidates[0].ID)
+}
+
+func Test_ResolverNearMisses(t *testing.T) {
+	for answer, wrong := range map[string]string{
+		"ME":   "MA",
+		"1788": "1787",
+		"NY":   "NJ",
+		"Lee":  "Lea",
+	} {
+		_, ok := resolve.New(resolve.Value{Name: answer}).Best(wrong)
+		require.False(t, ok, "%s accepted for %s", wrong, answer)
+	}
+
+	// similar names score under a stricter threshold
+	for answer, wrong := range map[string]string{
+		"Arkansas": "Kansas",
+		"Columbia": "Columbus",
+	} {
+		r := resolve.New(resolve.Value{Name: answer})
+		best, ok := r.Best(wrong)
+		require.True(t, ok, wrong)
+		require.Equal(t, resolve.MatchEditDistance, best.Method)
+
+		r.Threshold = 0.85
+		_, ok = r.Best(wrong)
+		require.False(t, ok, "%s accepted for %s", wrong, answer)
+	}
+}
+
+func Test_ResolveIntent(t *testing.T) {
+	colors := resolve.New(
+		resolve.Value{ID: "RED", Name: "red", Synonyms: []string{"crimson"}},
+		resolve.Value{ID: "BLUE", Name: "blue"},
+	)
+
+	intent := alexa.NewIntent("AnswerIntent").SetSlot("Color", "").SetSlot("City", "crimsen")
+	best, ok := colors.ResolveIntent(*intent)
+	require.True(t, ok)
+	require.Equal(t, "RED", best.ID)
+	require.Equal(t, "crimson", best.Matched)
+
+	slot := alexa.IntentSlot{Name: "Color", Value: "navy", Resolutions: &alexa.Resolutions{
+		ResolutionsPerAuthority: []alexa.Resolution{{
+			Authority: "amzn1.er-authority.echo-sdk.skill.Color",
+			Status:    alexa.ResolutionStatus{Code: alexa.ResolutionMatch},
+			Values:    []alexa.ResolutionItem{{Value: alexa.ResolvedValue{Name: "blue", ID: "BLUE"}}},
+		}},
+	}}
+	best, ok = colors.ResolveSlot(slot)
+	require.True(t, ok)
+	require.Equal(t, resolve.MatchResolution, best.Method)
+	require.Equal(t, "BLUE", best.ID)
+
+	_, ok = colors.ResolveSlot(alexa.IntentSlot{Name: "Color", Value: "navy"})
+	require.False(t, ok)
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/spirilis/askgo/alexa"
+	"github.com/spirilis/askgo/ssml"
 )
 
 // ResponseEnvelope wrapper around askgo.alexa type
@@ -15,19 +16,28 @@ type ResponseEnvelope struct {
 // ResponseBuilder interface for building requests
 type ResponseBuilder interface {
 	Speak(speechOutput string) *ResponseEnvelope
+	SpeakText(text string) *ResponseEnvelope
 	Reprompt(speechOutput string) *ResponseEnvelope
+	RepromptText(text string) *ResponseEnvelope
+	SpeechText() string
 	WithSimpleCard(cardTitle, cardContent string) *ResponseEnvelope
+	WithSimpleCardFromSpeech(cardTitle string) *ResponseEnvelope
 	WithStandardCard(cardTitle, cardContent string, smallImageURL, largeImageURL *string) *ResponseEnvelope
+	WithStandardCardFromSpeech(cardTitle string, smallImageURL, largeImageURL *string) *ResponseEnvelope
 	WithLinkAccountCard() *ResponseEnvelope
 	WithAskForPermissionsConsentCard(permissions []string) *ResponseEnvelope
 	AddDelegateDirective(updatedIntent *alexa.Intent) *ResponseEnvelope
 	AddElicitSlotDirective(slotToElicit string, updatedIntent *alexa.Intent) *ResponseEnvelope
 	AddConfirmSlotDirective(slotToConfirm string, updatedIntent *alexa.Intent) *ResponseEnvelope
 	AddConfirmIntentDirective(updatedIntent *alexa.Intent) *ResponseEnvelope
+	AddUpdateDynamicEntitiesDirective(behavior string, types []alexa.EntityListItem) *ResponseEnvelope
+	AddClearDynamicEntitiesDirective() *ResponseEnvelope
 	AddAudioPlayerPlayDirective(playBehavior, url, token string, offsetInMilliseconds int, expectedPreviousToken *string, audioItemMetadata *alexa.AudioItemMetadata) *ResponseEnvelope
 	AddAudioPlayerStopDirective() *ResponseEnvelope
 	AddAudioPlayerClearQueueDirective(clearBehavior string) *ResponseEnvelope
 	AddRenderTemplateDirective(template alexa.DisplayTemplate) *ResponseEnvelope
+	AddAPLRenderDocumentDirective(token string, document interface{}, datasources map[string]interface{}) *ResponseEnvelope
+	AddAPLExecuteCommandsDirective(token string, commands ...alexa.APLCommand) *ResponseEnvelope
 	AddHintDirective(text string) *ResponseEnvelope
 	AddVideoAppLaunchDirective(source string, title, subtitle *string) *ResponseEnvelope
 	WithShouldEndSession(val bool) *ResponseEnvelope
@@ -82,6 +92,44 @@ func (envelope *ResponseEnvelope) Reprompt(speechOutput string) *ResponseEnvelop
 	return envelope
 }
 
+// SpeakText - have Alexa say the provided plain text, no markup is interpreted
+func (envelope *ResponseEnvelope) SpeakText(text string) *ResponseEnvelope {
+	response := envelope.getResponse()
+	response.OutputSpeech = &alexa.OutputSpeech{
+		Type: "PlainText",
+		Text: text,
+	}
+
+	return envelope
+}
+
+// RepromptText - like Reprompt but with plain text speech
+func (envelope *ResponseEnvelope) RepromptText(text string) *ResponseEnvelope {
+	response := envelope.getResponse()
+
+	response.Reprompt = &alexa.Reprompt{
+		OutputSpeech: &alexa.OutputSpeech{
+			Type: "PlainText",
+			Text: text,
+		},
+	}
+
+	return envelope
+}
+
+// SpeechText returns the output speech rendered as plain text, SSML markup is dropped
+// while sub aliases and sentence breaks are kept
+func (envelope *ResponseEnvelope) SpeechText() string {
+	if envelope.Response == nil || envelope.Response.OutputSpeech == nil {
+		return ""
+	}
+	speech := envelope.Response.OutputSpeech
+	if speech.Type == "PlainText" {
+		return speech.Text
+	}
+	return ssml.PlainText(speech.SSML)
+}
+
 // WithSimpleCard renders a simple card with the following title and content
 func (envelope *ResponseEnvelope) WithSimpleCard(cardTitle, cardContent string) *ResponseEnvelope {
 	response := envelope.getResponse()
@@ -118,6 +166,18 @@ func (envelope *ResponseEnvelope) WithStandardCard(cardTitle, cardContent string
 	return envelope
 }
 
+// WithSimpleCardFromSpeech renders a simple card whose content is the output speech as
+// plain text, call it after Speak
+func (envelope *ResponseEnvelope) WithSimpleCardFromSpeech(cardTitle string) *ResponseEnvelope {
+	return envelope.WithSimpleCard(cardTitle, envelope.SpeechText())
+}
+
+// WithStandardCardFromSpeech renders a standard card whose text is the output speech as
+// plain text, call it after Speak
+func (envelope *ResponseEnvelope) WithStandardCardFromSpeech(cardTitle string, smallImageURL, largeImageURL *string) *ResponseEnvelope {
+	return envelope.WithStandardCard(cardTitle, envelope.SpeechText(), smallImageURL, largeImageURL)
+}
+
 // WithLinkAccountCard - renders a link account card
 func (envelope *ResponseEnvelope) WithLinkAccountCard() *ResponseEnvelope {
 	response := envelope.getResponse()
@@ -175,6 +235,21 @@ func (envelope *ResponseEnvelope) AddConfirmIntentDirective(updatedIntent *alexa
 	})
 }
 
+// AddUpdateDynamicEntitiesDirective adds or replaces the dynamic entities of the session,
+// behavior is alexa.DynamicEntitiesReplace or alexa.DynamicEntitiesClear
+func (envelope *ResponseEnvelope) AddUpdateDynamicEntitiesDirective(behavior string, types []alexa.EntityListItem) *ResponseEnvelope {
+	return envelope.AddDirective(&alexa.DialogUpdateDynamicEntitiesDirective{
+		Type:           "Dialog.UpdateDynamicEntities",
+		UpdateBehavior: behavior,
+		Types:          types,
+	})
+}
+
+// AddClearDynamicEntitiesDirective removes the dynamic entities of the session
+func (envelope *ResponseEnvelope) AddClearDynamicEntitiesDirective() *ResponseEnvelope {
+	return envelope.AddUpdateDynamicEntitiesDirective(alexa.DynamicEntitiesClear, nil)
+}
+
 // AddAudioPlayerPlayDirective -
 func (envelope *ResponseEnvelope) AddAudioPlayerPlayDirective(
 	playBehavior string,
@@ -227,6 +302,27 @@ func (envelope *ResponseEnvelope) AddRenderTemplateDirective(template alexa.Disp
 	})
 }
 
+// AddAPLRenderDocumentDirective shows an APL document, given inline or as an alexa.APLLink,
+// bound to the datasources.  The token names the document for ExecuteCommands and the
+// UserEvent requests it sends.
+func (envelope *ResponseEnvelope) AddAPLRenderDocumentDirective(token string, document interface{}, datasources map[string]interface{}) *ResponseEnvelope {
+	return envelope.AddDirective(&alexa.APLRenderDocumentDirective{
+		Type:        alexa.APLRenderDocument,
+		Token:       token,
+		Document:    document,
+		Datasources: datasources,
+	})
+}
+
+// AddAPLExecuteCommandsDirective runs commands on the APL document shown with the token
+func (envelope *ResponseEnvelope) AddAPLExecuteCommandsDirective(token string, commands ...alexa.APLCommand) *ResponseEnvelope {
+	return envelope.AddDirective(&alexa.APLExecuteCommandsDirective{
+		Type:     alexa.APLExecuteCommands,
+		Token:    token,
+		Commands: commands,
+	})
+}
+
 // AddHintDirective -
 func (envelope *ResponseEnvelope) AddHintDirective(text string) *ResponseEnvelope {
 	return envelope.AddDirective(&alexa.HintDirective{
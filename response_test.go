@@ -1,9 +1,11 @@
 package askgo_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/spirilis/askgo"
+	"github.com/spirilis/askgo/alexa"
 	"github.com/stretchr/testify/require"
 )
 
@@ -14,3 +16,69 @@ func Test_Interface(t *testing.T) {
 
 	require.True(t, env.Response.ShouldSessionEnd, "Session End")
 }
+
+func Test_SpeakText(t *testing.T) {
+	env := &askgo.ResponseEnvelope{}
+
+	env.SpeakText("Q & A").RepromptText("Ready?")
+
+	require.Equal(t, "PlainText", env.Response.OutputSpeech.Type)
+	require.Equal(t, "Q & A", env.Response.OutputSpeech.Text)
+	require.Equal(t, "Ready?", env.Response.Reprompt.OutputSpeech.Text)
+	require.Equal(t, "Q & A", env.SpeechText())
+}
+
+func Test_CardFromSpeech(t *testing.T) {
+	env := &askgo.ResponseEnvelope{}
+
+	env.Speak("<say-as interpret-as='interjection'>Bam</say-as><break strength='strong'/> The capital of <sub alias='Alabama'>AL</sub> is Montgomery.").
+		WithSimpleCardFromSpeech("Alabama")
+
+	require.Equal(t, "Bam The capital of Alabama is Montgomery.", env.Response.Card.Content)
+}
+
+func Test_DynamicEntities(t *testing.T) {
+	env := &askgo.ResponseEnvelope{}
+	env.AddUpdateDynamicEntitiesDirective(alexa.DynamicEntitiesReplace, []alexa.EntityListItem{
+		alexa.NewEntityList("Dish",
+			alexa.NewEntity("SOUP", "soup of the day", "soup"),
+			alexa.NewEntity("", "risotto")),
+	}).AddClearDynamicEntitiesDirective()
+
+	data, err := json.Marshal(env.Response.Directives)
+	require.NoError(t, err)
+	require.JSONEq(t, `[
+		{"type": "Dialog.UpdateDynamicEntities", "updateBehavior": "REPLACE", "types": [
+			{"name": "Dish", "values": [
+				{"id": "SOUP", "name": {"value": "soup of the day", "synonyms": ["soup"]}},
+				{"name": {"value": "risotto"}}
+			]}
+		]},
+		{"type": "Dialog.UpdateDynamicEntities", "updateBehavior": "CLEAR"}
+	]`, string(data))
+
+	launch := askgo.RequestEnvelope{Request: alexa.Request{Type: "LaunchRequest"}}
+	require.Empty(t, askgo.ValidateResponse(launch, env))
+}
+
+func Test_APLDirectives(t *testing.T) {
+	env := &askgo.ResponseEnvelope{}
+	env.AddAPLRenderDocumentDirective("quiz", alexa.NewAPLLink("quiz"), map[string]interface{}{"question": "Capital of Ohio?"}).
+		AddAPLExecuteCommandsDirective("quiz", alexa.APLSpeakItemCommand{ComponentID: "question"})
+
+	data, err := json.Marshal(env.Response.Directives)
+	require.NoError(t, err)
+	require.JSONEq(t, `[
+		{"type": "Alexa.Presentation.APL.RenderDocument", "token": "quiz",
+			"document": {"type": "Link", "src": "doc://alexa/apl/documents/quiz"},
+			"datasources": {"question": "Capital of Ohio?"}},
+		{"type": "Alexa.Presentation.APL.ExecuteCommands", "token": "quiz",
+			"commands": [{"type": "SpeakItem", "componentId": "question"}]}
+	]`, string(data))
+
+	request := askgo.RequestEnvelope{Request: alexa.Request{Type: "IntentRequest"}}
+	require.Equal(t, []string{askgo.RuleAPLInterface, askgo.RuleAPLInterface}, violationRules(askgo.ValidateResponse(request, env)))
+
+	request.Context.System.Device.SupportedInterfaces.APL = &alexa.APLInterface{Runtime: alexa.APLRuntime{MaxVersion: "2023.2"}}
+	require.Empty(t, askgo.ValidateResponse(request, env))
+}
@@ -0,0 +1,158 @@
+package slottype
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Granularity is the span of time an AMAZON.DATE value covers
+type Granularity string
+
+// Granularities of a DateRange
+const (
+	Day     Granularity = "day"
+	Week    Granularity = "week"
+	Weekend Granularity = "weekend"
+	Month   Granularity = "month"
+	Season  Granularity = "season"
+	Year    Granularity = "year"
+	Decade  Granularity = "decade"
+	Present Granularity = "present"
+)
+
+// DateRange is the span of time an AMAZON.DATE value refers to, from the start of Start
+// up to but not including End.  PRESENT_REF ("now") is an empty range at the reference
+// time.
+type DateRange struct {
+	Start       time.Time
+	End         time.Time
+	Granularity Granularity
+}
+
+// Contains reports whether t falls within the range
+func (r DateRange) Contains(t time.Time) bool {
+	return !t.Before(r.Start) && t.Before(r.End)
+}
+
+// seasons maps the season codes to their first month, each lasting three months as the
+// meteorological seasons of the northern hemisphere do
+var seasons = map[string]time.Month{
+	"SP": time.March,
+	"SU": time.June,
+	"FA": time.September,
+	"WI": time.December,
+}
+
+// ParseDate parses an AMAZON.DATE value.  Alexa sends a full date (2026-10-16), a week
+// (2026-W42), a weekend (2026-W42-WE), a month (2026-10), a season (2026-SU), a year
+// (2026), a decade (202X), PRESENT_REF, or a date without a year (XXXX-10-16).  Dates are
+// midnight in the location of now, and a date without a year is its next occurrence
+// on or after now, XXXX-02-29 being in the next leap year.
+func ParseDate(value string, now time.Time) (DateRange, error) {
+	if value == "?" {
+		return DateRange{}, ErrUnknown
+	}
+	if value == "PRESENT_REF" {
+		return DateRange{Start: now, End: now, Granularity: Present}, nil
+	}
+
+	loc := now.Location()
+	bad := func() (DateRange, error) {
+		return DateRange{}, fmt.Errorf("unrecognized date %q", value)
+	}
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, loc)
+	}
+
+	parts := strings.Split(value, "-")
+	yearText := parts[0]
+	if len(yearText) != 4 {
+		return bad()
+	}
+
+	// Decade, such as 201X
+	if len(parts) == 1 && strings.HasSuffix(yearText, "X") && yearText != "XXXX" {
+		decade, err := strconv.Atoi(yearText[:3])
+		if err != nil {
+			return bad()
+		}
+		start := date(decade*10, time.January, 1)
+		return DateRange{Start: start, End: start.AddDate(10, 0, 0), Granularity: Decade}, nil
+	}
+
+	year, err := strconv.Atoi(yearText)
+	anyYear := yearText == "XXXX"
+	if err != nil && !anyYear {
+		return bad()
+	}
+	if anyYear {
+		year = now.Year()
+	}
+
+	if len(parts) == 1 {
+		if anyYear {
+			return bad()
+		}
+		start := date(year, time.January, 1)
+		return DateRange{Start: start, End: start.AddDate(1, 0, 0), Granularity: Year}, nil
+	}
+
+	second := parts[1]
+	switch {
+	case len(parts) == 2 && seasons[second] != 0 && !anyYear:
+		start := date(year, seasons[second], 1)
+		return DateRange{Start: start, End: start.AddDate(0, 3, 0), Granularity: Season}, nil
+
+	case strings.HasPrefix(second, "W") && !anyYear:
+		week, err := strconv.Atoi(second[1:])
+		if err != nil || week < 1 || week > 53 {
+			return bad()
+		}
+		// ISO weeks start on Monday, week 1 holds January 4th
+		jan4 := date(year, time.January, 4)
+		monday := jan4.AddDate(0, 0, -((int(jan4.Weekday()) + 6) % 7))
+		start := monday.AddDate(0, 0, (week-1)*7)
+		// only some years have a week 53
+		if y, w := start.ISOWeek(); y != year || w != week {
+			return bad()
+		}
+		switch {
+		case len(parts) == 2:
+			return DateRange{Start: start, End: start.AddDate(0, 0, 7), Granularity: Week}, nil
+		case len(parts) == 3 && parts[2] == "WE":
+			saturday := start.AddDate(0, 0, 5)
+			return DateRange{Start: saturday, End: saturday.AddDate(0, 0, 2), Granularity: Weekend}, nil
+		}
+		return bad()
+	}
+
+	month, err := strconv.Atoi(second)
+	if err != nil || month < 1 || month > 12 {
+		return bad()
+	}
+
+	if len(parts) == 2 {
+		start := date(year, time.Month(month), 1)
+		if anyYear && !start.AddDate(0, 1, 0).After(now) {
+			start = start.AddDate(1, 0, 0)
+		}
+		return DateRange{Start: start, End: start.AddDate(0, 1, 0), Granularity: Month}, nil
+	}
+
+	day, err := strconv.Atoi(parts[2])
+	if len(parts) != 3 || err != nil || day < 1 || day > 31 {
+		return bad()
+	}
+	start := date(year, time.Month(month), day)
+	// a date without a year is its next occurrence, February 29th can be up to 8 years away
+	for anyYear && (start.Day() != day || start.Before(midnight(now))) && year < now.Year()+8 {
+		year++
+		start = date(year, time.Month(month), day)
+	}
+	if start.Day() != day {
+		return bad()
+	}
+	return DateRange{Start: start, End: start.AddDate(0, 0, 1), Granularity: Day}, nil
+}
@@ -0,0 +1,82 @@
+package slottype
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// ParseDuration parses an ISO-8601 AMAZON.DURATION value such as PT10M, P2W or P1DT2H30M.
+// Years, months, weeks and days are calendar units, so they are added to from and the
+// difference returned; the hours, minutes and seconds may have a fraction (PT1.5H).
+func ParseDuration(value string, from time.Time) (time.Duration, error) {
+	if value == "?" {
+		return 0, ErrUnknown
+	}
+	bad := func() (time.Duration, error) {
+		return 0, fmt.Errorf("unrecognized duration %q", value)
+	}
+	if len(value) < 3 || value[0] != 'P' {
+		return bad()
+	}
+
+	var years, months, days int
+	var clock time.Duration
+	inTime, timeParts := false, 0
+	number := ""
+	for _, r := range value[1:] {
+		switch {
+		case r >= '0' && r <= '9' || r == '.':
+			number += string(r)
+			continue
+		case r == 'T' && !inTime && number == "":
+			inTime = true
+			continue
+		}
+
+		if number == "" {
+			return bad()
+		}
+		if !inTime {
+			n, err := strconv.Atoi(number)
+			if err != nil {
+				return bad()
+			}
+			switch r {
+			case 'Y':
+				years += n
+			case 'M':
+				months += n
+			case 'W':
+				days += n * 7
+			case 'D':
+				days += n
+			default:
+				return bad()
+			}
+		} else {
+			f, err := strconv.ParseFloat(number, 64)
+			if err != nil {
+				return bad()
+			}
+			switch r {
+			case 'H':
+				clock += time.Duration(f * float64(time.Hour))
+			case 'M':
+				clock += time.Duration(f * float64(time.Minute))
+			case 'S':
+				clock += time.Duration(f * float64(time.Second))
+			default:
+				return bad()
+			}
+			timeParts++
+		}
+		number = ""
+	}
+	// a T has to be followed by at least one time part
+	if number != "" || inTime && timeParts == 0 {
+		return bad()
+	}
+
+	return from.AddDate(years, months, days).Add(clock).Sub(from), nil
+}
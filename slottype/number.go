@@ -0,0 +1,35 @@
+package slottype
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// ParseNumber parses an AMAZON.NUMBER value, which may be negative
+func ParseNumber(value string) (int, error) {
+	if value == "?" {
+		return 0, ErrUnknown
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("unrecognized number %q", value)
+	}
+	return n, nil
+}
+
+// ParseFourDigitNumber parses an AMAZON.FOUR_DIGIT_NUMBER value, such as a year or a PIN.
+// Leading zeros are part of the value, so it is returned as text.
+func ParseFourDigitNumber(value string) (string, error) {
+	if value == "?" {
+		return "", ErrUnknown
+	}
+	if len(value) != 4 {
+		return "", fmt.Errorf("unrecognized four digit number %q", value)
+	}
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return "", fmt.Errorf("unrecognized four digit number %q", value)
+		}
+	}
+	return value, nil
+}
@@ -0,0 +1,64 @@
+// Package slottype parses the values Alexa sends for the AMAZON.DATE, AMAZON.DURATION,
+// AMAZON.TIME, AMAZON.NUMBER and AMAZON.FOUR_DIGIT_NUMBER slot types.
+//
+// Relative values such as "this weekend" or "in the morning" are resolved against a
+// reference time, normally the request timestamp in the user's time zone:
+//
+//	now, err := slottype.RequestTime(input.GetRequest(), location)
+//	dates, err := slottype.ParseDate(slot.Value, now)
+package slottype
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spirilis/askgo/alexa"
+)
+
+// ErrUnknown is returned for a "?" value, sent when Alexa heard the slot but could not
+// make out its value
+var ErrUnknown = errors.New("slot value was not understood")
+
+// RequestTime returns the timestamp of the request in the location, or the current time
+// when the request has no timestamp.  A nil location is UTC.
+func RequestTime(request alexa.Request, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	if request.Timestamp == "" {
+		return time.Now().In(loc), nil
+	}
+	t, err := time.Parse(time.RFC3339, request.Timestamp)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("request timestamp %q: %v", request.Timestamp, err)
+	}
+	return t.In(loc), nil
+}
+
+// midnight returns the start of the day of t in its location
+func midnight(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+type locationKey struct{}
+
+// NewContext returns a context carrying the time zone of the user, used to resolve
+// relative slot values
+func NewContext(ctx context.Context, loc *time.Location) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, locationKey{}, loc)
+}
+
+// LocationFromContext returns the time zone stored in the context, or UTC if there is none
+func LocationFromContext(ctx context.Context) *time.Location {
+	if ctx != nil {
+		if loc, ok := ctx.Value(locationKey{}).(*time.Location); ok && loc != nil {
+			return loc
+		}
+	}
+	return time.UTC
+}
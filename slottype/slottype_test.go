@@ -0,0 +1,119 @@
+package slottype_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spirilis/askgo/alexa"
+	"github.com/spirilis/askgo/slottype"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseDate(t *testing.T) {
+	chicago, err := time.LoadLocation("America/Chicago")
+	require.NoError(t, err)
+
+	// 2026-10-17T03:00:00Z is still Friday evening in Chicago
+	now, err := slottype.RequestTime(alexa.Request{Timestamp: "2026-10-17T03:00:00Z"}, chicago)
+	require.NoError(t, err)
+	require.Equal(t, 16, now.Day())
+
+	day := func(year int, month time.Month, d int) time.Time {
+		return time.Date(year, month, d, 0, 0, 0, 0, chicago)
+	}
+
+	for _, c := range []struct {
+		value       string
+		start, end  time.Time
+		granularity slottype.Granularity
+	}{
+		{"2026-10-16", day(2026, 10, 16), day(2026, 10, 17), slottype.Day},
+		{"XXXX-10-16", day(2026, 10, 16), day(2026, 10, 17), slottype.Day},
+		{"XXXX-10-15", day(2027, 10, 15), day(2027, 10, 16), slottype.Day},
+		{"XXXX-02-29", day(2028, 2, 29), day(2028, 3, 1), slottype.Day},
+		{"2026-W42", day(2026, 10, 12), day(2026, 10, 19), slottype.Week},
+		{"2026-W42-WE", day(2026, 10, 17), day(2026, 10, 19), slottype.Weekend},
+		{"2026-W53", day(2026, 12, 28), day(2027, 1, 4), slottype.Week},
+		{"2026-W01", day(2025, 12, 29), day(2026, 1, 5), slottype.Week},
+		{"2026-10", day(2026, 10, 1), day(2026, 11, 1), slottype.Month},
+		{"2026-SU", day(2026, 6, 1), day(2026, 9, 1), slottype.Season},
+		{"2026-WI", day(2026, 12, 1), day(2027, 3, 1), slottype.Season},
+		{"2026", day(2026, 1, 1), day(2027, 1, 1), slottype.Year},
+		{"201X", day(2010, 1, 1), day(2020, 1, 1), slottype.Decade},
+		{"PRESENT_REF", now, now, slottype.Present},
+	} {
+		r, err := slottype.ParseDate(c.value, now)
+		require.NoError(t, err, c.value)
+		require.True(t, c.start.Equal(r.Start), "%s start %v", c.value, r.Start)
+		require.True(t, c.end.Equal(r.End), "%s end %v", c.value, r.End)
+		require.Equal(t, c.granularity, r.Granularity, c.value)
+	}
+
+	r, _ := slottype.ParseDate("2026-W42-WE", now)
+	require.True(t, r.Contains(day(2026, 10, 18).Add(23*time.Hour)))
+	require.False(t, r.Contains(day(2026, 10, 19)))
+
+	for _, bad := range []string{"", "2026-13", "2026-02-30", "XXXX-02-30", "2026-W60", "2027-W53", "2027-W53-WE", "XXXX", "2026-XX", "tomorrow"} {
+		_, err := slottype.ParseDate(bad, now)
+		require.Error(t, err, bad)
+	}
+	_, err = slottype.ParseDate("?", now)
+	require.Equal(t, slottype.ErrUnknown, err)
+}
+
+func Test_ParseTime(t *testing.T) {
+	day := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+
+	r, err := slottype.ParseTime("14:30", day)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2026, 10, 16, 14, 30, 0, 0, time.UTC), r.Start)
+	require.Equal(t, r.Start, r.End)
+
+	r, err = slottype.ParseTime("NI", day)
+	require.NoError(t, err)
+	require.Equal(t, slottype.Night, r.Period)
+	require.Equal(t, time.Date(2026, 10, 17, 5, 0, 0, 0, time.UTC), r.End)
+
+	_, err = slottype.ParseTime("25:00", day)
+	require.Error(t, err)
+	_, err = slottype.ParseTime("?", day)
+	require.Equal(t, slottype.ErrUnknown, err)
+}
+
+func Test_ParseDuration(t *testing.T) {
+	from := time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	for value, want := range map[string]time.Duration{
+		"PT10M":     10 * time.Minute,
+		"PT1.5H":    90 * time.Minute,
+		"P1DT2H30M": 26*time.Hour + 30*time.Minute,
+		"P2W":       14 * 24 * time.Hour,
+		"P1M":       31 * 24 * time.Hour,
+		"P1Y":       365 * 24 * time.Hour,
+	} {
+		d, err := slottype.ParseDuration(value, from)
+		require.NoError(t, err, value)
+		require.Equal(t, want, d, value)
+	}
+
+	for _, bad := range []string{"", "P", "10M", "PT", "P1H", "PT1D", "P1.5D", "PTXM", "P1YT", "P1DT"} {
+		_, err := slottype.ParseDuration(bad, from)
+		require.Error(t, err, bad)
+	}
+}
+
+func Test_ParseNumber(t *testing.T) {
+	n, err := slottype.ParseNumber("-12")
+	require.NoError(t, err)
+	require.Equal(t, -12, n)
+	_, err = slottype.ParseNumber("?")
+	require.Equal(t, slottype.ErrUnknown, err)
+
+	pin, err := slottype.ParseFourDigitNumber("0042")
+	require.NoError(t, err)
+	require.Equal(t, "0042", pin)
+	_, err = slottype.ParseFourDigitNumber("42")
+	require.Error(t, err)
+	_, err = slottype.ParseFourDigitNumber("?")
+	require.Equal(t, slottype.ErrUnknown, err)
+}
@@ -0,0 +1,66 @@
+package slottype
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Periods of the day sent for AMAZON.TIME
+const (
+	Morning   = "MO"
+	Afternoon = "AF"
+	Evening   = "EV"
+	Night     = "NI"
+)
+
+// periods are the hours each period of the day starts and ends, night runs past midnight
+// into the next day
+var periods = map[string][2]int{
+	Morning:   {5, 12},
+	Afternoon: {12, 17},
+	Evening:   {17, 21},
+	Night:     {21, 29},
+}
+
+// TimeRange is the time an AMAZON.TIME value refers to.  An exact time has Start equal
+// to End, a period of the day such as "in the morning" spans Start up to End and sets
+// Period.
+type TimeRange struct {
+	Start  time.Time
+	End    time.Time
+	Period string
+}
+
+// ParseTime parses an AMAZON.TIME value, either a time (14:30 or 14:30:15) or one of the
+// periods MO, AF, EV and NI, on the day of the given time and in its location
+func ParseTime(value string, day time.Time) (TimeRange, error) {
+	if value == "?" {
+		return TimeRange{}, ErrUnknown
+	}
+
+	at := func(hour, minute, second int) time.Time {
+		return time.Date(day.Year(), day.Month(), day.Day(), hour, minute, second, 0, day.Location())
+	}
+	if hours, ok := periods[value]; ok {
+		return TimeRange{Start: at(hours[0], 0, 0), End: at(hours[1], 0, 0), Period: value}, nil
+	}
+
+	parts := strings.Split(value, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return TimeRange{}, fmt.Errorf("unrecognized time %q", value)
+	}
+	limits := []int{24, 60, 60}
+	clock := []int{0, 0, 0}
+	for n, p := range parts {
+		v, err := strconv.Atoi(p)
+		if err != nil || v < 0 || v >= limits[n] {
+			return TimeRange{}, fmt.Errorf("unrecognized time %q", value)
+		}
+		clock[n] = v
+	}
+
+	t := at(clock[0], clock[1], clock[2])
+	return TimeRange{Start: t, End: t}, nil
+}
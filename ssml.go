@@ -0,0 +1,59 @@
+package askgo
+
+import (
+	"log"
+
+	"github.com/spirilis/askgo/alexa"
+	"github.com/spirilis/askgo/ssml"
+)
+
+// SSMLValidator is a ResponseInterceptor that checks the output speech and reprompt of
+// every response against the SSML subset and limits supported by Alexa, so malformed
+// speech is caught before a device answers with "There was a problem".
+type SSMLValidator struct {
+	// DevMode rewrites invalid speech into something Alexa accepts and logs the problems
+	// instead of returning an error
+	DevMode bool
+}
+
+var _ ResponseInterceptor = &SSMLValidator{}
+
+// Process validates the speech of the response, returning an *ssml.Error unless DevMode is set
+func (v *SSMLValidator) Process(input HandlerInput, response *ResponseEnvelope) error {
+	if response == nil || response.Response == nil {
+		return nil
+	}
+
+	ssmlOf := func(speech *alexa.OutputSpeech) string {
+		if speech == nil || speech.Type != "SSML" {
+			return ""
+		}
+		return speech.SSML
+	}
+	var reprompt string
+	if response.Response.Reprompt != nil {
+		reprompt = ssmlOf(response.Response.Reprompt.OutputSpeech)
+	}
+
+	err := ssml.ValidateResponse(ssmlOf(response.Response.OutputSpeech), reprompt)
+	if err == nil {
+		return nil
+	}
+	if !v.DevMode {
+		return err
+	}
+
+	log.Printf("SSMLValidator rewriting response: %v", err)
+	allowed := ssml.MaxAudio
+	if speech := response.Response.OutputSpeech; speech != nil && speech.Type == "SSML" {
+		speech.SSML = ssml.Sanitize(speech.SSML, allowed)
+		allowed -= ssml.AudioCount(speech.SSML)
+	}
+	if response.Response.Reprompt != nil {
+		if speech := response.Response.Reprompt.OutputSpeech; speech != nil && speech.Type == "SSML" {
+			speech.SSML = ssml.Sanitize(speech.SSML, allowed)
+		}
+	}
+
+	return nil
+}
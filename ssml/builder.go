@@ -0,0 +1,249 @@
+// Package ssml builds Speech Synthesis Markup Language for Alexa responses.
+//
+// Plain text added to a Builder is escaped, so slot values containing & or < cannot
+// produce invalid markup.  Elements that wrap other speech take a *Builder as content:
+//
+//	speech := ssml.New().
+//		SayAs(ssml.Interjection, "bazinga").
+//		Break(ssml.Strong).
+//		Text("The capital of ").Text(state).Text(" is ").
+//		Emphasis(ssml.Moderate, ssml.Text(capital))
+//
+//	input.GetResponse().Speak(speech.String())
+package ssml
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Strength of a break
+type Strength string
+
+// Break strengths
+const (
+	NoBreak Strength = "none"
+	XWeak   Strength = "x-weak"
+	Weak    Strength = "weak"
+	Medium  Strength = "medium"
+	Strong  Strength = "strong"
+	XStrong Strength = "x-strong"
+)
+
+// InterpretAs is the interpret-as attribute of say-as
+type InterpretAs string
+
+// Values of interpret-as supported by Alexa
+const (
+	Characters   InterpretAs = "characters"
+	SpellOut     InterpretAs = "spell-out"
+	Cardinal     InterpretAs = "cardinal"
+	Number       InterpretAs = "number"
+	Ordinal      InterpretAs = "ordinal"
+	Digits       InterpretAs = "digits"
+	Fraction     InterpretAs = "fraction"
+	Unit         InterpretAs = "unit"
+	Date         InterpretAs = "date"
+	Time         InterpretAs = "time"
+	Telephone    InterpretAs = "telephone"
+	Address      InterpretAs = "address"
+	Interjection InterpretAs = "interjection"
+	Expletive    InterpretAs = "expletive"
+)
+
+// EmphasisLevel is the level attribute of emphasis
+type EmphasisLevel string
+
+// Emphasis levels
+const (
+	StrongEmphasis EmphasisLevel = "strong"
+	Moderate       EmphasisLevel = "moderate"
+	Reduced        EmphasisLevel = "reduced"
+)
+
+// Phonetic alphabets of phoneme
+const (
+	IPA    = "ipa"
+	XSAMPA = "x-sampa"
+)
+
+// Prosody holds the attributes of a prosody element, empty attributes are left out.
+// Rate is x-slow to x-fast or a percentage, Pitch is x-low to x-high or a relative
+// percentage, Volume is silent to x-loud or a relative dB value.
+type Prosody struct {
+	Rate   string
+	Pitch  string
+	Volume string
+}
+
+// Values of amazon:effect, amazon:emotion and amazon:domain
+const (
+	Whispered = "whispered"
+
+	Excited      = "excited"
+	Disappointed = "disappointed"
+
+	Conversational = "conversational"
+	LongForm       = "long-form"
+	Music          = "music"
+	News           = "news"
+	Fun            = "fun"
+)
+
+// Builder accumulates SSML markup
+type Builder struct {
+	markup strings.Builder
+}
+
+// New returns an empty builder
+func New() *Builder {
+	return &Builder{}
+}
+
+// Text returns a new builder holding the escaped text, handy as element content
+func Text(text string) *Builder {
+	return New().Text(text)
+}
+
+// Escape escapes the characters that are special in SSML text and attribute values
+func Escape(text string) string {
+	return escaper.Replace(text)
+}
+
+var escaper = strings.NewReplacer(
+	"&", "&amp;",
+	"<", "&lt;",
+	">", "&gt;",
+	`"`, "&quot;",
+	"'", "&apos;",
+)
+
+func (b *Builder) element(name string, attrs []string, content *Builder) *Builder {
+	b.markup.WriteString("<" + name)
+	for n := 0; n+1 < len(attrs); n += 2 {
+		if attrs[n+1] != "" {
+			fmt.Fprintf(&b.markup, ` %s="%s"`, attrs[n], Escape(attrs[n+1]))
+		}
+	}
+	if content == nil {
+		b.markup.WriteString("/>")
+		return b
+	}
+	b.markup.WriteString(">")
+	b.markup.WriteString(content.Markup())
+	b.markup.WriteString("</" + name + ">")
+	return b
+}
+
+// Text adds plain text, escaping any markup characters
+func (b *Builder) Text(text string) *Builder {
+	b.markup.WriteString(Escape(text))
+	return b
+}
+
+// Raw adds markup as-is, the caller is responsible for it being valid
+func (b *Builder) Raw(markup string) *Builder {
+	b.markup.WriteString(markup)
+	return b
+}
+
+// Append adds the markup of another builder
+func (b *Builder) Append(other *Builder) *Builder {
+	return b.Raw(other.Markup())
+}
+
+// Break adds a pause of the given strength
+func (b *Builder) Break(strength Strength) *Builder {
+	return b.element("break", []string{"strength", string(strength)}, nil)
+}
+
+// BreakTime adds a pause of the given duration, Alexa allows up to 10 seconds
+func (b *Builder) BreakTime(d time.Duration) *Builder {
+	return b.element("break", []string{"time", fmt.Sprintf("%dms", d.Milliseconds())}, nil)
+}
+
+// SayAs adds text to be interpreted as the given type
+func (b *Builder) SayAs(interpretAs InterpretAs, text string) *Builder {
+	return b.SayAsFormat(interpretAs, "", text)
+}
+
+// SayAsFormat adds text to be interpreted as the given type and format, such as a date
+// with format "mdy"
+func (b *Builder) SayAsFormat(interpretAs InterpretAs, format, text string) *Builder {
+	return b.element("say-as", []string{"interpret-as", string(interpretAs), "format", format}, Text(text))
+}
+
+// Prosody changes the rate, pitch or volume of the content
+func (b *Builder) Prosody(prosody Prosody, content *Builder) *Builder {
+	return b.element("prosody", []string{"rate", prosody.Rate, "pitch", prosody.Pitch, "volume", prosody.Volume}, content)
+}
+
+// Emphasis adds content spoken with the given emphasis
+func (b *Builder) Emphasis(level EmphasisLevel, content *Builder) *Builder {
+	return b.element("emphasis", []string{"level", string(level)}, content)
+}
+
+// Audio plays an MP3 file from an HTTPS URL or the Alexa sound library
+func (b *Builder) Audio(src string) *Builder {
+	return b.element("audio", []string{"src", src}, nil)
+}
+
+// Voice speaks the content with an Amazon Polly voice
+func (b *Builder) Voice(name string, content *Builder) *Builder {
+	return b.element("voice", []string{"name", name}, content)
+}
+
+// Lang speaks the content in another language, such as fr-FR
+func (b *Builder) Lang(lang string, content *Builder) *Builder {
+	return b.element("lang", []string{"xml:lang", lang}, content)
+}
+
+// Phoneme speaks text with the given phonetic pronunciation
+func (b *Builder) Phoneme(alphabet, ph, text string) *Builder {
+	return b.element("phoneme", []string{"alphabet", alphabet, "ph", ph}, Text(text))
+}
+
+// Sub speaks alias in place of the text
+func (b *Builder) Sub(alias, text string) *Builder {
+	return b.element("sub", []string{"alias", alias}, Text(text))
+}
+
+// Paragraph adds the content as a paragraph
+func (b *Builder) Paragraph(content *Builder) *Builder {
+	return b.element("p", nil, content)
+}
+
+// Sentence adds the content as a sentence
+func (b *Builder) Sentence(content *Builder) *Builder {
+	return b.element("s", nil, content)
+}
+
+// Effect applies an amazon:effect such as Whispered to the content
+func (b *Builder) Effect(name string, content *Builder) *Builder {
+	return b.element("amazon:effect", []string{"name", name}, content)
+}
+
+// Emotion speaks the content with an amazon:emotion (Excited or Disappointed) at an
+// intensity of low, medium or high
+func (b *Builder) Emotion(name, intensity string, content *Builder) *Builder {
+	return b.element("amazon:emotion", []string{"name", name, "intensity", intensity}, content)
+}
+
+// Domain speaks the content in a speaking style such as Conversational or News
+func (b *Builder) Domain(name string, content *Builder) *Builder {
+	return b.element("amazon:domain", []string{"name", name}, content)
+}
+
+// Markup returns the markup without the enclosing speak element
+func (b *Builder) Markup() string {
+	if b == nil {
+		return ""
+	}
+	return b.markup.String()
+}
+
+// String returns the complete SSML document, ready for ResponseEnvelope.Speak
+func (b *Builder) String() string {
+	return "<speak>" + b.Markup() + "</speak>"
+}
@@ -0,0 +1,36 @@
+package ssml_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spirilis/askgo"
+	"github.com/spirilis/askgo/ssml"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Builder(t *testing.T) {
+	speech := ssml.New().
+		SayAs(ssml.Interjection, "bazinga").
+		Break(ssml.Strong).
+		Text("Q&A <time> for ").
+		Emphasis(ssml.Moderate, ssml.Text("Tom's")).
+		BreakTime(1500*time.Millisecond).
+		Prosody(ssml.Prosody{Rate: "slow", Volume: "+6dB"}, ssml.Text("slowly")).
+		Sub("Alabama", "AL").
+		Effect(ssml.Whispered, ssml.New().Sentence(ssml.Text("secret")))
+
+	require.Equal(t,
+		`<speak><say-as interpret-as="interjection">bazinga</say-as><break strength="strong"/>`+
+			`Q&amp;A &lt;time&gt; for <emphasis level="moderate">Tom&apos;s</emphasis><break time="1500ms"/>`+
+			`<prosody rate="slow" volume="+6dB">slowly</prosody><sub alias="Alabama">AL</sub>`+
+			`<amazon:effect name="whispered"><s>secret</s></amazon:effect></speak>`,
+		speech.String())
+}
+
+func Test_BuilderSpeak(t *testing.T) {
+	env := &askgo.ResponseEnvelope{}
+	env.Speak(ssml.New().Lang("fr-FR", ssml.Text("bonjour")).String())
+
+	require.Equal(t, `<speak><lang xml:lang="fr-FR">bonjour</lang></speak>`, env.Response.OutputSpeech.SSML)
+}
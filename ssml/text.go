@@ -0,0 +1,92 @@
+package ssml
+
+import (
+	"encoding/xml"
+	"strings"
+	"unicode"
+)
+
+// PlainText renders SSML as plain text suitable for a card or display: markup is dropped,
+// sub elements are replaced by their alias, audio is left out, and paragraphs and
+// sentences keep their breaks.  The speech may be given with or without the enclosing
+// speak element.
+func PlainText(speech string) string {
+	speech = strings.TrimSpace(speech)
+	if !strings.HasPrefix(speech, "<speak") {
+		speech = "<speak>" + speech + "</speak>"
+	}
+
+	var paragraphs []string
+	var current strings.Builder
+	// depth of elements whose content is being replaced or skipped
+	skip := 0
+
+	endSentence := func() {
+		text := strings.TrimRightFunc(current.String(), unicode.IsSpace)
+		if text == "" {
+			return
+		}
+		if !strings.ContainsRune(".!?;:", rune(text[len(text)-1])) {
+			text += "."
+		}
+		current.Reset()
+		current.WriteString(text + " ")
+	}
+	endParagraph := func() {
+		endSentence()
+		if text := strings.Join(strings.Fields(current.String()), " "); text != "" {
+			paragraphs = append(paragraphs, text)
+		}
+		current.Reset()
+	}
+
+	err := walk(speech, func(token xml.Token, problems []Problem) {
+		switch t := token.(type) {
+		case xml.StartElement:
+			if skip > 0 {
+				skip++
+				return
+			}
+			switch qualifiedName(t.Name) {
+			case "sub":
+				for _, attr := range t.Attr {
+					if attr.Name.Local == "alias" {
+						current.WriteString(attr.Value)
+					}
+				}
+				skip = 1
+			case "audio":
+				skip = 1
+			case "p":
+				endParagraph()
+			case "s":
+				endSentence()
+			case "break":
+				current.WriteString(" ")
+			}
+		case xml.EndElement:
+			if skip > 0 {
+				skip--
+				return
+			}
+			switch qualifiedName(t.Name) {
+			case "p":
+				endParagraph()
+			case "s":
+				endSentence()
+			}
+		case xml.CharData:
+			if skip == 0 {
+				current.Write(t)
+			}
+		}
+	})
+	if err != nil {
+		return stripTags(speech)
+	}
+
+	if text := strings.Join(strings.Fields(current.String()), " "); text != "" {
+		paragraphs = append(paragraphs, text)
+	}
+	return strings.Join(paragraphs, "\n\n")
+}
@@ -0,0 +1,25 @@
+package ssml_test
+
+import (
+	"testing"
+
+	"github.com/spirilis/askgo/ssml"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_PlainText(t *testing.T) {
+	speech := ssml.New().
+		Paragraph(ssml.New().
+			Sentence(ssml.Text("Welcome to the quiz")).
+			Sentence(ssml.Text("Ready?"))).
+		Audio("soundbank://soundlibrary/ui/gameshow/amzn_ui_sfx_gameshow_intro_01").
+		Paragraph(ssml.New().
+			Text("The abbreviation is ").
+			SayAs(ssml.SpellOut, "AL").
+			Text(" for ").
+			Sub("Alabama", "AL"))
+
+	require.Equal(t, "Welcome to the quiz. Ready?\n\nThe abbreviation is AL for Alabama.", ssml.PlainText(speech.String()))
+	require.Equal(t, "Tom & Jerry", ssml.PlainText("Tom &amp; Jerry"))
+	require.Equal(t, "broken", ssml.PlainText("<speak><p>broken</speak>"))
+}
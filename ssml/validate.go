@@ -0,0 +1,409 @@
+package ssml
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Limits Alexa places on output speech
+const (
+	// MaxLength is the most characters allowed in a single output speech
+	MaxLength = 8000
+	// MaxAudio is the most audio elements allowed in a response
+	MaxAudio = 5
+	// MaxBreakMilliseconds is the longest pause a break may request
+	MaxBreakMilliseconds = 10000
+)
+
+// Problem is a single reason Alexa would reject a piece of SSML
+type Problem struct {
+	// Source names the speech with the problem, such as outputSpeech or reprompt, it is
+	// left empty by Validate
+	Source string
+	// Element is the tag the problem was found in, empty for document wide problems
+	Element string
+	Message string
+}
+
+func (p Problem) String() string {
+	msg := p.Message
+	if p.Element != "" {
+		msg = "<" + p.Element + ">: " + msg
+	}
+	if p.Source != "" {
+		msg = p.Source + ": " + msg
+	}
+	return msg
+}
+
+// Error lists every problem found in SSML
+type Error struct {
+	Problems []Problem
+}
+
+func (e *Error) Error() string {
+	msgs := make([]string, len(e.Problems))
+	for n, p := range e.Problems {
+		msgs[n] = p.String()
+	}
+	return "invalid SSML: " + strings.Join(msgs, "; ")
+}
+
+var (
+	strengths      = []string{"none", "x-weak", "weak", "medium", "strong", "x-strong"}
+	interpretAs    = []string{"characters", "spell-out", "cardinal", "number", "ordinal", "digits", "fraction", "unit", "date", "time", "telephone", "address", "interjection", "expletive"}
+	emphasisLevels = []string{"strong", "moderate", "reduced"}
+	alphabets      = []string{"ipa", "x-sampa"}
+	emotions       = []string{"excited", "disappointed"}
+	intensities    = []string{"low", "medium", "high"}
+	domains        = []string{"conversational", "long-form", "music", "news", "fun"}
+	wordRoles      = []string{"amazon:VB", "amazon:VBD", "amazon:NN", "amazon:SENSE_1"}
+	languages      = []string{"en-US", "en-GB", "en-IN", "en-AU", "en-CA", "de-DE", "es-ES", "es-MX", "es-US", "fr-FR", "fr-CA", "hi-IN", "it-IT", "ja-JP", "pt-BR"}
+	rates          = []string{"x-slow", "slow", "medium", "fast", "x-fast"}
+	pitches        = []string{"x-low", "low", "medium", "high", "x-high"}
+	volumes        = []string{"silent", "x-soft", "soft", "medium", "loud", "x-loud"}
+
+	breakTimePattern = regexp.MustCompile(`^(\d+(?:\.\d+)?)(ms|s)$`)
+	percentPattern   = regexp.MustCompile(`^[+-]?\d+(?:\.\d+)?%$`)
+	decibelPattern   = regexp.MustCompile(`^[+-]\d+(?:\.\d+)?dB$`)
+)
+
+// attrRule checks an attribute value, returning a problem description or ""
+type attrRule func(value string) string
+
+func oneOf(values []string) attrRule {
+	return func(value string) string {
+		for _, v := range values {
+			if v == value {
+				return ""
+			}
+		}
+		return fmt.Sprintf("%q is not one of %s", value, strings.Join(values, ", "))
+	}
+}
+
+func oneOfOr(values []string, pattern *regexp.Regexp) attrRule {
+	return func(value string) string {
+		if pattern.MatchString(value) {
+			return ""
+		}
+		return oneOf(values)(value)
+	}
+}
+
+func notEmpty(value string) string {
+	if strings.TrimSpace(value) == "" {
+		return "must not be empty"
+	}
+	return ""
+}
+
+func breakTime(value string) string {
+	m := breakTimePattern.FindStringSubmatch(value)
+	if m == nil {
+		return fmt.Sprintf("%q is not a time such as 500ms or 2s", value)
+	}
+	n, _ := strconv.ParseFloat(m[1], 64)
+	if m[2] == "s" {
+		n *= 1000
+	}
+	if n > MaxBreakMilliseconds {
+		return fmt.Sprintf("%q is longer than 10 seconds", value)
+	}
+	return ""
+}
+
+func audioSource(value string) string {
+	if strings.HasPrefix(value, "https://") || strings.HasPrefix(value, "soundbank://") {
+		return ""
+	}
+	return fmt.Sprintf("%q must be an https:// or soundbank:// URL", value)
+}
+
+// elementRule describes the attributes allowed on a supported element
+type elementRule struct {
+	attrs    map[string]attrRule
+	required []string
+	empty    bool
+}
+
+// Elements and attributes in the subset of SSML supported by Alexa
+var elementRules = map[string]elementRule{
+	"speak":          {},
+	"p":              {},
+	"s":              {},
+	"amazon:domain":  {attrs: map[string]attrRule{"name": oneOf(domains)}, required: []string{"name"}},
+	"amazon:effect":  {attrs: map[string]attrRule{"name": oneOf([]string{"whispered"})}, required: []string{"name"}},
+	"amazon:emotion": {attrs: map[string]attrRule{"name": oneOf(emotions), "intensity": oneOf(intensities)}, required: []string{"name", "intensity"}},
+	"audio":          {attrs: map[string]attrRule{"src": audioSource}, required: []string{"src"}, empty: true},
+	"break":          {attrs: map[string]attrRule{"strength": oneOf(strengths), "time": breakTime}, empty: true},
+	"emphasis":       {attrs: map[string]attrRule{"level": oneOf(emphasisLevels)}},
+	"lang":           {attrs: map[string]attrRule{"xml:lang": oneOf(languages)}, required: []string{"xml:lang"}},
+	"phoneme":        {attrs: map[string]attrRule{"alphabet": oneOf(alphabets), "ph": notEmpty}, required: []string{"alphabet", "ph"}},
+	"prosody": {attrs: map[string]attrRule{
+		"rate":   oneOfOr(rates, percentPattern),
+		"pitch":  oneOfOr(pitches, percentPattern),
+		"volume": oneOfOr(volumes, decibelPattern),
+	}},
+	"say-as": {attrs: map[string]attrRule{"interpret-as": oneOf(interpretAs), "format": notEmpty}, required: []string{"interpret-as"}},
+	"sub":    {attrs: map[string]attrRule{"alias": notEmpty}, required: []string{"alias"}},
+	"voice":  {attrs: map[string]attrRule{"name": notEmpty}, required: []string{"name"}},
+	"w":      {attrs: map[string]attrRule{"role": oneOf(wordRoles)}, required: []string{"role"}},
+}
+
+func qualifiedName(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+	return name.Space + ":" + name.Local
+}
+
+// checkElement returns the problems of a single start element
+func checkElement(start xml.StartElement) []Problem {
+	name := qualifiedName(start.Name)
+	rule, ok := elementRules[name]
+	if !ok {
+		return []Problem{{Element: name, Message: "unsupported element"}}
+	}
+
+	var problems []Problem
+	seen := map[string]bool{}
+	for _, attr := range start.Attr {
+		attrName := qualifiedName(attr.Name)
+		seen[attrName] = true
+
+		check, ok := rule.attrs[attrName]
+		if !ok {
+			problems = append(problems, Problem{Element: name, Message: fmt.Sprintf("unsupported attribute %s", attrName)})
+			continue
+		}
+		if msg := check(attr.Value); msg != "" {
+			problems = append(problems, Problem{Element: name, Message: attrName + " " + msg})
+		}
+	}
+	for _, attrName := range rule.required {
+		if !seen[attrName] {
+			problems = append(problems, Problem{Element: name, Message: fmt.Sprintf("missing attribute %s", attrName)})
+		}
+	}
+
+	return problems
+}
+
+// walk decodes the SSML, calling fn for every token.  Start elements are passed along
+// with their problems.  It returns an error if the markup is not well formed.
+func walk(speech string, fn func(token xml.Token, problems []Problem)) error {
+	decoder := xml.NewDecoder(strings.NewReader(speech))
+	decoder.Strict = true
+
+	var stack []string
+	sawRoot := false
+	for {
+		token, err := decoder.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			name := qualifiedName(t.Name)
+			if len(stack) == 0 {
+				if sawRoot || name != "speak" {
+					return fmt.Errorf("the document must be a single <speak> element")
+				}
+				sawRoot = true
+			} else if name == "speak" {
+				return fmt.Errorf("<speak> cannot be nested")
+			}
+			stack = append(stack, name)
+			fn(t.Copy(), checkElement(t))
+		case xml.EndElement:
+			name := qualifiedName(t.Name)
+			if len(stack) == 0 || stack[len(stack)-1] != name {
+				return fmt.Errorf("unexpected </%s>", name)
+			}
+			stack = stack[:len(stack)-1]
+			fn(t, nil)
+		case xml.CharData:
+			if len(stack) == 0 {
+				if strings.TrimSpace(string(t)) != "" {
+					return fmt.Errorf("text outside of <speak>")
+				}
+				continue
+			}
+			fn(t.Copy(), nil)
+		case xml.ProcInst, xml.Directive:
+			return fmt.Errorf("processing instructions and directives are not supported")
+		}
+	}
+
+	if !sawRoot {
+		return fmt.Errorf("the document must be a single <speak> element")
+	}
+	if len(stack) != 0 {
+		return fmt.Errorf("<%s> is not closed", stack[len(stack)-1])
+	}
+	return nil
+}
+
+// AudioCount returns the number of audio elements in the SSML
+func AudioCount(speech string) int {
+	count := 0
+	walk(speech, func(token xml.Token, problems []Problem) {
+		if start, ok := token.(xml.StartElement); ok && qualifiedName(start.Name) == "audio" {
+			count++
+		}
+	})
+	return count
+}
+
+// Validate checks SSML against the subset of elements and attribute values supported by
+// Alexa and the length and audio limits.  It returns an *Error listing every problem, or
+// nil when the speech is acceptable.
+func Validate(speech string) error {
+	problems, audio := validate(speech)
+	if audio > MaxAudio {
+		problems = append(problems, Problem{Element: "audio", Message: fmt.Sprintf("%d audio elements, the limit is %d", audio, MaxAudio)})
+	}
+
+	if len(problems) > 0 {
+		return &Error{Problems: problems}
+	}
+	return nil
+}
+
+// ValidateResponse checks the output speech and reprompt of a response like Validate, an
+// empty string standing for speech that is not there.  Problems are reported with the
+// speech they were found in as their Source, and the audio limit, which applies to the
+// response as a whole, is reported once.
+func ValidateResponse(outputSpeech, reprompt string) error {
+	var problems []Problem
+	audio := 0
+	for _, speech := range []struct{ source, ssml string }{{"outputSpeech", outputSpeech}, {"reprompt", reprompt}} {
+		if speech.ssml == "" {
+			continue
+		}
+		found, n := validate(speech.ssml)
+		for _, p := range found {
+			p.Source = speech.source
+			problems = append(problems, p)
+		}
+		audio += n
+	}
+	if audio > MaxAudio {
+		problems = append(problems, Problem{Element: "audio", Message: fmt.Sprintf("%d audio elements in the response, the limit is %d", audio, MaxAudio)})
+	}
+
+	if len(problems) > 0 {
+		return &Error{Problems: problems}
+	}
+	return nil
+}
+
+// validate returns the problems of the speech other than the audio limit, along with
+// the number of audio elements
+func validate(speech string) ([]Problem, int) {
+	var problems []Problem
+
+	if n := utf8.RuneCountInString(speech); n > MaxLength {
+		problems = append(problems, Problem{Message: fmt.Sprintf("speech is %d characters, the limit is %d", n, MaxLength)})
+	}
+
+	audio := 0
+	err := walk(speech, func(token xml.Token, elementProblems []Problem) {
+		problems = append(problems, elementProblems...)
+		if start, ok := token.(xml.StartElement); ok && qualifiedName(start.Name) == "audio" {
+			audio++
+		}
+	})
+	if err != nil {
+		problems = append(problems, Problem{Message: "malformed: " + err.Error()})
+	}
+	return problems, audio
+}
+
+// Sanitize rewrites SSML so that Alexa will accept it: elements that are unsupported or
+// have invalid attributes are removed while keeping their content, audio elements past the
+// limit are dropped, and markup that cannot be parsed is reduced to escaped text.  The root
+// <speak> is always kept, without its attributes if they are not supported.  Speech
+// that is still too long is cut down to plain text within the limit.  maxAudio is the
+// number of audio elements still allowed, use MaxAudio for a single speech.
+func Sanitize(speech string, maxAudio int) string {
+	var out strings.Builder
+	// for each open element, whether its tags are being kept
+	var keep []bool
+	audio := 0
+
+	err := walk(speech, func(token xml.Token, problems []Problem) {
+		switch t := token.(type) {
+		case xml.StartElement:
+			name := qualifiedName(t.Name)
+			if name == "speak" && len(problems) > 0 {
+				keep = append(keep, true)
+				out.WriteString("<speak>")
+				return
+			}
+			ok := len(problems) == 0
+			if ok && name == "audio" {
+				audio++
+				ok = audio <= maxAudio
+			}
+			keep = append(keep, ok)
+			if !ok {
+				return
+			}
+			out.WriteString("<" + name)
+			for _, attr := range t.Attr {
+				fmt.Fprintf(&out, ` %s="%s"`, qualifiedName(attr.Name), Escape(attr.Value))
+			}
+			if elementRules[name].empty {
+				out.WriteString("/")
+			}
+			out.WriteString(">")
+		case xml.EndElement:
+			name := qualifiedName(t.Name)
+			ok := keep[len(keep)-1]
+			keep = keep[:len(keep)-1]
+			if ok && !elementRules[name].empty {
+				out.WriteString("</" + name + ">")
+			}
+		case xml.CharData:
+			out.WriteString(Escape(string(t)))
+		}
+	})
+
+	result := out.String()
+	if err != nil {
+		result = "<speak>" + Escape(stripTags(speech)) + "</speak>"
+	}
+	if utf8.RuneCountInString(result) > MaxLength {
+		text := Escape(PlainText(result))
+		limit := MaxLength - len("<speak></speak>")
+		if runes := []rune(text); len(runes) > limit {
+			text = string(runes[:limit])
+			// don't leave a partial entity behind
+			if amp := strings.LastIndex(text, "&"); amp >= 0 && !strings.Contains(text[amp:], ";") {
+				text = text[:amp]
+			}
+		}
+		result = "<speak>" + text + "</speak>"
+	}
+
+	return result
+}
+
+var tagPattern = regexp.MustCompile(`<[^>]*>`)
+
+// stripTags removes anything that looks like a tag from markup that could not be parsed
+func stripTags(markup string) string {
+	text := tagPattern.ReplaceAllString(markup, " ")
+	return strings.Join(strings.Fields(text), " ")
+}
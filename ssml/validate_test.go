@@ -0,0 +1,77 @@
+package ssml_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/spirilis/askgo/ssml"
+	"github.com/stretchr/testify/require"
+)
+
+func problems(t *testing.T, speech string) []string {
+	err := ssml.Validate(speech)
+	if err == nil {
+		return nil
+	}
+	var sErr *ssml.Error
+	require.True(t, errors.As(err, &sErr))
+
+	var msgs []string
+	for _, p := range sErr.Problems {
+		msgs = append(msgs, p.String())
+	}
+	return msgs
+}
+
+func Test_Validate(t *testing.T) {
+	require.Empty(t, problems(t, ssml.New().
+		SayAs(ssml.Interjection, "boom").
+		BreakTime(0).
+		Audio("soundbank://soundlibrary/animals/amzn_sfx_bear_groan_roar_01").
+		Lang("de-DE", ssml.Text("hallo")).
+		Emotion(ssml.Excited, "high", ssml.Text("yay")).
+		String()))
+
+	require.Equal(t, []string{
+		"<say-as>: interpret-as \"shout\" is not one of characters, spell-out, cardinal, number, ordinal, digits, fraction, unit, date, time, telephone, address, interjection, expletive",
+		"<blink>: unsupported element",
+		"<break>: time \"11s\" is longer than 10 seconds",
+		"<audio>: src \"http://example.com/a.mp3\" must be an https:// or soundbank:// URL",
+		"<prosody>: unsupported attribute speed",
+	}, problems(t, `<speak><say-as interpret-as="shout">hi</say-as><blink>x</blink><break time="11s"/>`+
+		`<audio src="http://example.com/a.mp3"/><prosody speed="fast">y</prosody></speak>`))
+
+	require.Equal(t, []string{"malformed: unexpected </s>"}, problems(t, "<speak><p>Q and A</s></speak>"))
+	require.Equal(t, []string{"malformed: text outside of <speak>"}, problems(t, "hello"))
+
+	audio := `<speak>` + strings.Repeat(`<audio src="https://example.com/a.mp3"/>`, 6) + `</speak>`
+	require.Equal(t, []string{"<audio>: 6 audio elements, the limit is 5"}, problems(t, audio))
+
+	long := ssml.New().Text(strings.Repeat("a", ssml.MaxLength)).String()
+	require.Equal(t, []string{"speech is 8015 characters, the limit is 8000"}, problems(t, long))
+}
+
+func Test_Sanitize(t *testing.T) {
+	require.Equal(t,
+		`<speak>hi x<break strength="strong"/></speak>`,
+		ssml.Sanitize(`<speak><say-as interpret-as="shout">hi</say-as> <blink>x</blink><break strength="strong"/></speak>`, ssml.MaxAudio))
+
+	require.Equal(t, "<speak>Q &amp; A</speak>", ssml.Sanitize("<speak>Q & A</speak>", ssml.MaxAudio))
+	require.Equal(t, "<speak>hello</speak>", ssml.Sanitize(`<speak xmlns="http://www.w3.org/2001/10/synthesis">hello</speak>`, ssml.MaxAudio))
+
+	audio := `<speak>` + strings.Repeat(`<audio src="https://example.com/a.mp3"/>`, 3) + `</speak>`
+	require.Equal(t, 1, ssml.AudioCount(ssml.Sanitize(audio, 1)))
+
+	long := ssml.New().Paragraph(ssml.Text(strings.Repeat("a&", ssml.MaxLength))).String()
+	sanitized := ssml.Sanitize(long, ssml.MaxAudio)
+	require.NoError(t, ssml.Validate(sanitized))
+
+	accented := "<speak>" + strings.Repeat("é", 7000) + strings.Repeat("a&", 600) + "</speak>"
+	sanitized = ssml.Sanitize(accented, ssml.MaxAudio)
+	require.NoError(t, ssml.Validate(sanitized))
+	require.True(t, utf8.ValidString(sanitized))
+	require.True(t, strings.HasPrefix(sanitized, "<speak>éé"))
+	require.True(t, utf8.RuneCountInString(sanitized) <= ssml.MaxLength)
+}
@@ -0,0 +1,48 @@
+package askgo_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/spirilis/askgo"
+	"github.com/spirilis/askgo/ssml"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SSMLValidator(t *testing.T) {
+	response := func() *askgo.ResponseEnvelope {
+		env := &askgo.ResponseEnvelope{}
+		return env.Speak("Q & A <blink>now</blink>").Reprompt("<break time='20s'/>ready?")
+	}
+
+	err := (&askgo.SSMLValidator{}).Process(nil, response())
+	var sErr *ssml.Error
+	require.True(t, errors.As(err, &sErr))
+	require.Len(t, sErr.Problems, 2)
+	require.Equal(t, "outputSpeech", sErr.Problems[0].Source)
+	require.Equal(t, "reprompt", sErr.Problems[1].Source)
+
+	env := response()
+	require.NoError(t, (&askgo.SSMLValidator{DevMode: true}).Process(nil, env))
+	require.Equal(t, "<speak>Q &amp; A now</speak>", env.Response.OutputSpeech.SSML)
+	require.Equal(t, "<speak>ready?</speak>", env.Response.Reprompt.OutputSpeech.SSML)
+}
+
+func Test_SSMLValidatorAudio(t *testing.T) {
+	audio := func(n int) string {
+		return strings.Repeat(`<audio src="https://example.com/a.mp3"/>`, n)
+	}
+	env := &askgo.ResponseEnvelope{}
+	env.Speak(audio(6)).Reprompt(audio(1))
+
+	err := (&askgo.SSMLValidator{}).Process(nil, env)
+	var sErr *ssml.Error
+	require.True(t, errors.As(err, &sErr))
+	require.Len(t, sErr.Problems, 1)
+	require.Equal(t, "<audio>: 7 audio elements in the response, the limit is 5", sErr.Problems[0].String())
+
+	require.NoError(t, (&askgo.SSMLValidator{DevMode: true}).Process(nil, env))
+	require.Equal(t, 5, ssml.AudioCount(env.Response.OutputSpeech.SSML))
+	require.Zero(t, ssml.AudioCount(env.Response.Reprompt.OutputSpeech.SSML))
+}
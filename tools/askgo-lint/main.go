@@ -0,0 +1,93 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/spirilis/askgo/model"
+)
+
+var helpString = `
+Syntax: askgo-lint model [-min-samples N] [-max-expansions N] <interaction model JSON file>...
+
+Expands the sample utterances of each interaction model (slot placeholders plus the
+values and synonyms of custom slot types) and reports:
+
+  invocation-name      invocation name rule violations
+  illegal-character    characters Alexa does not accept in a sample
+  shared-utterance     utterances that more than one intent can produce
+  ambiguous-samples    samples that cannot be told apart once their slots are filled
+  slot-only-sample     samples made only of slot references
+  unused-slot          slots not used in any sample
+  too-few-samples      custom intents with fewer than -min-samples samples (0 turns it off)
+  undefined-slot-type  slots whose custom type is not defined
+  expansion-limit      samples with more than -max-expansions utterances, only the
+                       first ones are checked
+
+The exit status is 1 when anything is reported.
+`
+
+func doHelp() {
+	fmt.Fprintln(os.Stderr, helpString)
+}
+
+func lintModel(args []string) int {
+	flags := flag.NewFlagSet("model", flag.ExitOnError)
+	minSamples := flags.Int("min-samples", 5, "fewest samples a custom intent should have, 0 turns the check off")
+	maxExpansions := flags.Int("max-expansions", 1000, "most utterances generated from a single sample")
+	flags.Usage = func() {
+		doHelp()
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return 2
+	}
+
+	status := 0
+	for _, path := range flags.Args() {
+		doc, err := model.Load(path)
+		if err != nil {
+			log.Printf("Error reading interaction model %s: %v", path, err)
+			status = 1
+			continue
+		}
+
+		// LintOptions takes 0 as the default and a negative value as off
+		samples := *minSamples
+		if samples == 0 {
+			samples = -1
+		}
+		findings := model.Lint(&doc.InteractionModel.LanguageModel, model.LintOptions{
+			MinSamples:    samples,
+			MaxExpansions: *maxExpansions,
+		})
+		for _, f := range findings {
+			fmt.Printf("%s: %s\n", path, f)
+		}
+		if len(findings) > 0 {
+			status = 1
+		}
+	}
+
+	return status
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		doHelp()
+		os.Exit(2)
+	}
+
+	switch os.Args[1] {
+	case "model":
+		os.Exit(lintModel(os.Args[2:]))
+	default:
+		doHelp()
+		os.Exit(2)
+	}
+}
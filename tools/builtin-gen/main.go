@@ -0,0 +1,140 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+	"unicode"
+
+	"gopkg.in/yaml.v3"
+)
+
+// entry is one built-in intent or slot type of the data file
+type entry struct {
+	Name    string   `yaml:"name"`
+	Const   string   `yaml:"const"`
+	Locales []string `yaml:"locales"`
+}
+
+type catalog struct {
+	Intents   []entry `yaml:"intents"`
+	SlotTypes []entry `yaml:"slotTypes"`
+}
+
+// constName derives the Go constant of a built-in: AMAZON.HelpIntent becomes HelpIntent
+// and AMAZON.FOUR_DIGIT_NUMBER becomes FourDigitNumber plus the suffix.  Short all
+// uppercase parts such as US and TV are kept as initialisms.
+func constName(name, suffix string) string {
+	name = strings.TrimPrefix(name, "AMAZON.")
+	parts := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	var out string
+	for _, p := range parts {
+		if strings.ToUpper(p) == p && len(p) > 2 {
+			p = strings.ToLower(p)
+		}
+		out += strings.ToUpper(p[:1]) + p[1:]
+	}
+	if !strings.HasSuffix(out, suffix) {
+		out += suffix
+	}
+	return out
+}
+
+func generateGroup(kind, suffix, mapName string, entries []entry) (string, error) {
+	var consts, table string
+	seen := map[string]string{}
+
+	for _, e := range entries {
+		if e.Name == "" {
+			return "", fmt.Errorf("%s without a name", kind)
+		}
+		c := e.Const
+		if c == "" {
+			c = constName(e.Name, suffix)
+		}
+		if other, ok := seen[c]; ok {
+			return "", fmt.Errorf("%s and %s both map to constant %s", other, e.Name, c)
+		}
+		seen[c] = e.Name
+
+		consts += fmt.Sprintf("\t// %s is %s\n\t%s = %q\n", c, e.Name, c, e.Name)
+		if len(e.Locales) == 0 {
+			table += fmt.Sprintf("\t%s: {Name: %s},\n", c, c)
+		} else {
+			table += fmt.Sprintf("\t%s: {Name: %s, Locales: %#v},\n", c, c, e.Locales)
+		}
+	}
+
+	return fmt.Sprintf("// Built-in %ss\nconst (\n%s)\n\n// %s describes every built-in %s by name\nvar %s = map[string]BuiltIn{\n%s}\n",
+		kind, consts, mapName, kind, mapName, table), nil
+}
+
+// GenerateCode emits the Go source for the built-in catalog
+func GenerateCode(pkg, source string, c *catalog) ([]byte, error) {
+	intents, err := generateGroup("intent", "Intent", "BuiltInIntents", c.Intents)
+	if err != nil {
+		return nil, err
+	}
+	slotTypes, err := generateGroup("slot type", "SlotType", "BuiltInSlotTypes", c.SlotTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	out := fmt.Sprintf("// Code generated by builtin-gen from %s; DO NOT EDIT.\n\npackage %s\n\n", source, pkg)
+	out += intents + "\n" + slotTypes
+
+	return format.Source([]byte(out))
+}
+
+var helpString = `
+Syntax: builtin-gen [-package name] [-o output.go] <built-in catalog YAML file>
+
+Generates Go constants and the BuiltInIntents and BuiltInSlotTypes tables from the
+catalog of Amazon built-in intents and slot types.  It is run by go generate in the
+alexa package:
+
+//go:generate go run ../tools/builtin-gen -o builtins.go builtins.yaml
+`
+
+func main() {
+	pkg := flag.String("package", "alexa", "package name of the generated file")
+	output := flag.String("o", "", "output file (default standard output)")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), helpString)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	data, err := os.ReadFile(flag.Arg(0))
+	if err != nil {
+		log.Fatalf("Error reading %s: %v", flag.Arg(0), err)
+	}
+	c := &catalog{}
+	if err := yaml.Unmarshal(data, c); err != nil {
+		log.Fatalf("Error parsing %s: %v", flag.Arg(0), err)
+	}
+
+	out, err := GenerateCode(*pkg, flag.Arg(0), c)
+	if err != nil {
+		log.Fatalf("GenerateCode() threw an error: %v", err)
+	}
+
+	if *output == "" {
+		os.Stdout.Write(out)
+		return
+	}
+	if err := os.WriteFile(*output, out, 0644); err != nil {
+		log.Fatalf("Error writing %s: %v", *output, err)
+	}
+}
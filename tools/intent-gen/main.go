@@ -0,0 +1,266 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/spirilis/askgo/model"
+)
+
+// slotConversion describes how the value of a slot type is converted into a Go value
+type slotConversion struct {
+	goType  string // type of the converted value
+	parse   string // slottype function parsing the value, empty for none
+	withNow bool   // parse also takes the time relative values are resolved against
+}
+
+var slotConversions = map[string]slotConversion{
+	"AMAZON.NUMBER":            {goType: "int", parse: "ParseNumber"},
+	"AMAZON.FOUR_DIGIT_NUMBER": {goType: "string", parse: "ParseFourDigitNumber"},
+	"AMAZON.DATE":              {goType: "slottype.DateRange", parse: "ParseDate", withNow: true},
+	"AMAZON.TIME":              {goType: "slottype.TimeRange", parse: "ParseTime", withNow: true},
+	"AMAZON.DURATION":          {goType: "time.Duration", parse: "ParseDuration", withNow: true},
+}
+
+// fieldType is the Go type of the struct field of a slot: converted values other than
+// text are pointers, nil when the slot was not given
+func (c slotConversion) fieldType() string {
+	if c.goType == "string" {
+		return c.goType
+	}
+	return "*" + c.goType
+}
+
+func conversionFor(slotType string) slotConversion {
+	if c, ok := slotConversions[slotType]; ok {
+		return c
+	}
+	return slotConversion{goType: "string"}
+}
+
+// goName turns an intent or slot name such as AMAZON.HelpIntent or state_name into
+// an exported Go identifier (AmazonHelpIntent, StateName)
+func goName(name string) string {
+	parts := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	var out string
+	for _, p := range parts {
+		if strings.ToUpper(p) == p {
+			p = strings.ToLower(p)
+		}
+		out += strings.ToUpper(p[:1]) + p[1:]
+	}
+	if out == "" || unicode.IsDigit(rune(out[0])) {
+		out = "X" + out
+	}
+	return out
+}
+
+// identifiers records the Go identifiers generated so far and what they were made from, as
+// goName may turn different names of the model into the same identifier
+type identifiers map[string]string
+
+func (ids identifiers) add(ident, from string) error {
+	if other, ok := ids[ident]; ok {
+		return fmt.Errorf("%s and %s both generate the Go identifier %s", other, from, ident)
+	}
+	ids[ident] = from
+	return nil
+}
+
+// checkNames reports the intents and slots whose generated identifiers collide
+func checkNames(lm *model.LanguageModel) error {
+	global := identifiers{}
+	for _, intent := range lm.Intents {
+		intentName := goName(intent.Name)
+		if err := global.add(intentName, "intent "+intent.Name); err != nil {
+			return err
+		}
+		if len(intent.Slots) == 0 {
+			continue
+		}
+		for _, ident := range []string{intentName + "Slots", "Decode" + intentName} {
+			if err := global.add(ident, "intent "+intent.Name); err != nil {
+				return err
+			}
+		}
+
+		fields := identifiers{}
+		for _, s := range intent.Slots {
+			from := fmt.Sprintf("slot %s of intent %s", s.Name, intent.Name)
+			if err := fields.add(goName(s.Name), from); err != nil {
+				return err
+			}
+			if err := global.add(intentName+goName(s.Name)+"Slot", from); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// GenerateCode emits the Go source for the intents in the language model
+func GenerateCode(pkg, source string, lm *model.LanguageModel) ([]byte, error) {
+	if err := checkNames(lm); err != nil {
+		return nil, err
+	}
+
+	var body string
+	var needFmt, needSlottype, needTime bool
+
+	body += "// Intent names from the interaction model\nconst (\n"
+	for _, intent := range lm.Intents {
+		body += fmt.Sprintf("\t%s = %q\n", goName(intent.Name), intent.Name)
+	}
+	body += ")\n"
+
+	for _, intent := range lm.Intents {
+		if len(intent.Slots) == 0 {
+			continue
+		}
+		intentName := goName(intent.Name)
+		structName := intentName + "Slots"
+		needFmt = true
+
+		withNow := false
+		for _, s := range intent.Slots {
+			withNow = withNow || conversionFor(s.Type).withNow
+		}
+
+		body += fmt.Sprintf("\n// Slot names of %s\nconst (\n", intent.Name)
+		for _, s := range intent.Slots {
+			body += fmt.Sprintf("\t%s%sSlot = %q\n", intentName, goName(s.Name), s.Name)
+		}
+		body += ")\n"
+
+		body += fmt.Sprintf("\n// %s holds the slot values of %s, a slot that was not given is left empty or nil\ntype %s struct {\n", structName, intent.Name, structName)
+		for _, s := range intent.Slots {
+			body += fmt.Sprintf("\t%s %s // %s\n", goName(s.Name), conversionFor(s.Type).fieldType(), s.Type)
+		}
+		body += "}\n"
+
+		if withNow {
+			needTime = true
+			body += fmt.Sprintf(`
+// Decode%s converts the slots of a %s request into %s,
+// resolving relative dates, times and durations against now (see slottype.RequestTime)
+func Decode%s(intent alexa.Intent, now time.Time) (*%s, error) {
+`, intentName, intent.Name, structName, intentName, structName)
+		} else {
+			body += fmt.Sprintf(`
+// Decode%s converts the slots of a %s request into %s
+func Decode%s(intent alexa.Intent) (*%s, error) {
+`, intentName, intent.Name, structName, intentName, structName)
+		}
+		body += fmt.Sprintf(`	if intent.Name != %s {
+		return nil, fmt.Errorf("intent %%s is not %%s", intent.Name, %s)
+	}
+
+	slots := &%s{}
+`, intentName, intentName, structName)
+
+		for _, s := range intent.Slots {
+			constName := intentName + goName(s.Name) + "Slot"
+			conv := conversionFor(s.Type)
+
+			// Alexa sends "?" for a value it heard but could not make out
+			body += fmt.Sprintf("\tif slot, ok := intent.Slots[%s]; ok && slot.Value != \"\" && slot.Value != \"?\" {\n", constName)
+			if conv.parse == "" {
+				body += fmt.Sprintf("\t\tslots.%s = slot.Value\n", goName(s.Name))
+			} else {
+				needSlottype = true
+				needTime = needTime || conv.goType == "time.Duration"
+				args := "slot.Value"
+				if conv.withNow {
+					args += ", now"
+				}
+				body += fmt.Sprintf("\t\tv, err := slottype.%s(%s)\n", conv.parse, args)
+				body += "\t\tif err != nil {\n"
+				body += fmt.Sprintf("\t\t\treturn nil, fmt.Errorf(\"slot %%s: %%v\", %s, err)\n", constName)
+				body += "\t\t}\n"
+				if conv.goType == "string" {
+					body += fmt.Sprintf("\t\tslots.%s = v\n", goName(s.Name))
+				} else {
+					body += fmt.Sprintf("\t\tslots.%s = &v\n", goName(s.Name))
+				}
+			}
+			body += "\t}\n"
+		}
+		body += "\n\treturn slots, nil\n}\n"
+	}
+
+	out := fmt.Sprintf("// Code generated by intent-gen from %s; DO NOT EDIT.\n\npackage %s\n\n", source, pkg)
+	if needFmt {
+		out += "import (\n\t\"fmt\"\n"
+		if needTime {
+			out += "\t\"time\"\n"
+		}
+		out += "\n\t\"github.com/spirilis/askgo/alexa\"\n"
+		if needSlottype {
+			out += "\t\"github.com/spirilis/askgo/slottype\"\n"
+		}
+		out += ")\n\n"
+	}
+	out += body
+
+	return format.Source([]byte(out))
+}
+
+var helpString = `
+Syntax: intent-gen [-package name] [-o output.go] <interaction model JSON file>
+
+Generates Go constants for every intent and slot in the interaction model, along
+with a <Intent>Slots struct and Decode<Intent>(alexa.Intent) function for each
+intent that has slots.  Slot values are parsed with the slottype package by slot
+type: AMAZON.NUMBER becomes *int and AMAZON.FOUR_DIGIT_NUMBER stays a string that
+keeps its leading zeros, AMAZON.DATE a *slottype.DateRange, AMAZON.TIME a
+*slottype.TimeRange and AMAZON.DURATION a *time.Duration, nil when the slot was not
+given.  Intents with date, time or duration slots get Decode<Intent>(alexa.Intent,
+time.Time), the time being what relative values are resolved against.  All other
+slots are left as string.  Intent or slot names that generate the same Go
+identifier are an error.
+
+Typical use is a go:generate line next to your handlers:
+
+//go:generate go run github.com/spirilis/askgo/tools/intent-gen -o intents_gen.go model/en-US.json
+`
+
+func main() {
+	pkg := flag.String("package", "main", "package name of the generated file")
+	output := flag.String("o", "", "output file (default standard output)")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), helpString)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	doc, err := model.Load(flag.Arg(0))
+	if err != nil {
+		log.Fatalf("Error reading interaction model %s: %v", flag.Arg(0), err)
+	}
+
+	out, err := GenerateCode(*pkg, flag.Arg(0), &doc.InteractionModel.LanguageModel)
+	if err != nil {
+		log.Fatalf("GenerateCode() threw an error: %v", err)
+	}
+
+	if *output == "" {
+		os.Stdout.Write(out)
+		return
+	}
+	if err := os.WriteFile(*output, out, 0644); err != nil {
+		log.Fatalf("Error writing %s: %v", *output, err)
+	}
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/spirilis/askgo/model"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files")
+
+func Test_GenerateCode(t *testing.T) {
+	doc, err := model.Load("testdata/model.json")
+	require.NoError(t, err)
+
+	out, err := GenerateCode("intents", "testdata/model.json", &doc.InteractionModel.LanguageModel)
+	require.NoError(t, err)
+
+	golden := filepath.Join("testdata", "intents_gen.go.golden")
+	if *update {
+		require.NoError(t, os.WriteFile(golden, out, 0644))
+	}
+	expected, err := os.ReadFile(golden)
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(out))
+
+	// the generated file has to compile against the askgo packages of this module
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go tool not found, not compiling the generated code")
+	}
+	dir := t.TempDir()
+	file := filepath.Join(dir, "intents_gen.go")
+	require.NoError(t, os.WriteFile(file, out, 0644))
+	build := exec.Command(goTool, "build", "-o", os.DevNull, file)
+	output, err := build.CombinedOutput()
+	require.NoError(t, err, string(output))
+}
+
+func Test_GenerateCodeCollision(t *testing.T) {
+	lm := &model.LanguageModel{
+		Intents: []model.Intent{
+			{Name: "Foo_Bar"},
+			{Name: "FooBar"},
+		},
+	}
+	_, err := GenerateCode("intents", "model.json", lm)
+	require.EqualError(t, err, "intent Foo_Bar and intent FooBar both generate the Go identifier FooBar")
+
+	lm = &model.LanguageModel{
+		Intents: []model.Intent{
+			{Name: "OrderIntent", Slots: []model.Slot{
+				{Name: "item_count", Type: "AMAZON.NUMBER"},
+				{Name: "itemCount", Type: "AMAZON.NUMBER"},
+			}},
+		},
+	}
+	_, err = GenerateCode("intents", "model.json", lm)
+	require.EqualError(t, err, "slot item_count of intent OrderIntent and slot itemCount of intent OrderIntent both generate the Go identifier ItemCount")
+}
@@ -0,0 +1,176 @@
+package askgo
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/spirilis/askgo/alexa"
+	"github.com/spirilis/askgo/ssml"
+)
+
+// Limits Alexa places on a response
+const (
+	// MaxResponseSize is the largest response, in bytes, Alexa accepts
+	MaxResponseSize = 24 * 1024
+	// MaxCardLength is the most characters allowed in the title and content of a card combined
+	MaxCardLength = 8000
+	// MaxImageURLLength is the longest card image URL
+	MaxImageURLLength = 2000
+	// MaxSpeechLength is the most characters allowed in an output speech
+	MaxSpeechLength = ssml.MaxLength
+)
+
+// Rules reported in Violation.Rule
+const (
+	RuleResponseSize      = "response-size"
+	RuleSpeechLength      = "speech-length"
+	RuleCardLength        = "card-length"
+	RuleCardImageURL      = "card-image-url"
+	RuleDialogRequestType = "dialog-request-type"
+	RuleDialogCount       = "dialog-count"
+	RuleAudioReprompt     = "audio-reprompt"
+	RuleAPLInterface      = "apl-interface"
+)
+
+// Violation is a single reason Alexa would reject a response
+type Violation struct {
+	Rule    string
+	Message string
+}
+
+func (v Violation) String() string {
+	return v.Rule + ": " + v.Message
+}
+
+// ResponseError is returned by ResponseValidator when a response breaks Alexa's rules
+type ResponseError struct {
+	Violations []Violation
+}
+
+func (e *ResponseError) Error() string {
+	msgs := make([]string, len(e.Violations))
+	for n, v := range e.Violations {
+		msgs[n] = v.String()
+	}
+	return "invalid response: " + strings.Join(msgs, "; ")
+}
+
+// directiveType returns the type of a directive, whether it is one of the alexa structs
+// or a map built by hand
+func directiveType(directive interface{}) string {
+	data, err := json.Marshal(directive)
+	if err != nil {
+		return ""
+	}
+	var typed struct {
+		Type string `json:"type"`
+	}
+	json.Unmarshal(data, &typed)
+	return typed.Type
+}
+
+// ValidateResponse checks a response against the platform limits Alexa enforces, taking
+// the type of the request it answers into account.  It returns every violation found.
+func ValidateResponse(req RequestEnvelope, resp *ResponseEnvelope) []Violation {
+	if resp == nil {
+		return nil
+	}
+
+	var violations []Violation
+	add := func(rule, format string, args ...interface{}) {
+		violations = append(violations, Violation{Rule: rule, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if data, err := json.Marshal(resp); err != nil {
+		add(RuleResponseSize, "response cannot be encoded: %v", err)
+	} else if len(data) > MaxResponseSize {
+		add(RuleResponseSize, "response is %d bytes, the limit is %d", len(data), MaxResponseSize)
+	}
+
+	response := resp.Response
+	if response == nil {
+		return violations
+	}
+
+	if speech := response.OutputSpeech; speech != nil {
+		if n := utf8.RuneCountInString(speech.Text + speech.SSML); n > MaxSpeechLength {
+			add(RuleSpeechLength, "output speech is %d characters, the limit is %d", n, MaxSpeechLength)
+		}
+	}
+
+	if card := response.Card; card != nil {
+		if n := utf8.RuneCountInString(card.Title + card.Content + card.Text); n > MaxCardLength {
+			add(RuleCardLength, "card title and text are %d characters, the limit is %d", n, MaxCardLength)
+		}
+		if card.Image != nil {
+			for _, url := range []string{card.Image.SmallImageURL, card.Image.LargeImageURL} {
+				if url == "" {
+					continue
+				}
+				if !strings.HasPrefix(url, "https://") {
+					add(RuleCardImageURL, "card image %s must use HTTPS", url)
+				}
+				if len(url) > MaxImageURLLength {
+					add(RuleCardImageURL, "card image URL is %d characters, the limit is %d", len(url), MaxImageURLLength)
+				}
+			}
+		}
+	}
+
+	dialogs := 0
+	audio := false
+	for _, directive := range response.Directives {
+		t := directiveType(directive)
+		switch {
+		case t == "Dialog.UpdateDynamicEntities":
+			// may answer any request, alongside another Dialog directive
+		case strings.HasPrefix(t, "Dialog."):
+			dialogs++
+			if req.Request.Type != "IntentRequest" {
+				add(RuleDialogRequestType, "%s directive cannot answer a %s", t, req.Request.Type)
+			}
+		case strings.HasPrefix(t, "AudioPlayer."):
+			audio = true
+		case strings.HasPrefix(t, "Alexa.Presentation.APL."):
+			if !req.Context.System.Device.SupportedInterfaces.Supports(alexa.InterfaceAPL) {
+				add(RuleAPLInterface, "%s directive sent to a device without APL", t)
+			}
+		}
+	}
+	if dialogs > 1 {
+		add(RuleDialogCount, "response has %d Dialog directives, only one is allowed", dialogs)
+	}
+	if audio && response.Reprompt != nil {
+		add(RuleAudioReprompt, "AudioPlayer directives cannot be sent with a reprompt")
+	}
+
+	return violations
+}
+
+// ResponseValidator is a ResponseInterceptor that runs ValidateResponse against every
+// response, returning a *ResponseError so tests and development builds catch responses
+// Alexa would reject.
+type ResponseValidator struct {
+	// LogOnly logs the violations instead of returning an error
+	LogOnly bool
+}
+
+var _ ResponseInterceptor = &ResponseValidator{}
+
+// Process validates the response against the request of the input
+func (v *ResponseValidator) Process(input HandlerInput, response *ResponseEnvelope) error {
+	violations := ValidateResponse(input.GetRequestEnvelope(), response)
+	if len(violations) == 0 {
+		return nil
+	}
+
+	err := &ResponseError{Violations: violations}
+	if v.LogOnly {
+		log.Printf("ResponseValidator: %v", err)
+		return nil
+	}
+	return err
+}
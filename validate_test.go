@@ -0,0 +1,71 @@
+package askgo_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/spirilis/askgo"
+	"github.com/spirilis/askgo/alexa"
+	"github.com/stretchr/testify/require"
+)
+
+func violationRules(violations []askgo.Violation) []string {
+	rules := []string{}
+	for _, v := range violations {
+		rules = append(rules, v.Rule)
+	}
+	return rules
+}
+
+func Test_ValidateResponse(t *testing.T) {
+	launch := askgo.RequestEnvelope{Request: alexa.Request{Type: "LaunchRequest"}}
+	intent := askgo.RequestEnvelope{Request: alexa.Request{Type: "IntentRequest"}}
+
+	small := "http://example.com/small.png"
+	env := &askgo.ResponseEnvelope{}
+	env.Speak("Hello").
+		Reprompt("Still there?").
+		WithStandardCard("Title", "Text", &small, nil).
+		AddDelegateDirective(nil).
+		AddDirective(map[string]interface{}{"type": "Dialog.ElicitSlot", "slotToElicit": "Name"}).
+		AddAudioPlayerStopDirective()
+
+	require.Equal(t, []string{
+		askgo.RuleCardImageURL,
+		askgo.RuleDialogRequestType,
+		askgo.RuleDialogRequestType,
+		askgo.RuleDialogCount,
+		askgo.RuleAudioReprompt,
+	}, violationRules(askgo.ValidateResponse(launch, env)))
+
+	require.Equal(t, []string{
+		askgo.RuleCardImageURL,
+		askgo.RuleDialogCount,
+		askgo.RuleAudioReprompt,
+	}, violationRules(askgo.ValidateResponse(intent, env)))
+
+	big := &askgo.ResponseEnvelope{}
+	big.Speak(strings.Repeat("a", 8000)).WithSimpleCard("Big", strings.Repeat("b", 20000))
+	require.Equal(t, []string{
+		askgo.RuleResponseSize,
+		askgo.RuleSpeechLength,
+		askgo.RuleCardLength,
+	}, violationRules(askgo.ValidateResponse(intent, big)))
+
+	ok := &askgo.ResponseEnvelope{}
+	require.Empty(t, askgo.ValidateResponse(intent, ok.Speak("Hello").AddDelegateDirective(nil)))
+}
+
+func Test_ResponseValidator(t *testing.T) {
+	input := askgo.NewDefaultHandler(context.Background(), &askgo.RequestEnvelope{Request: alexa.Request{Type: "LaunchRequest"}})
+	response := input.GetResponse().Speak("Hello").AddDelegateDirective(nil)
+
+	err := (&askgo.ResponseValidator{}).Process(input, response)
+	var rErr *askgo.ResponseError
+	require.True(t, errors.As(err, &rErr))
+	require.Len(t, rErr.Violations, 1)
+
+	require.NoError(t, (&askgo.ResponseValidator{LogOnly: true}).Process(input, response))
+}